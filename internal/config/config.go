@@ -0,0 +1,227 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"strings"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/repository"
+	serverPkg "aoroa/pkg/server"
+)
+
+// Log levels
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+// Config is the complete server configuration
+type Config struct {
+	Server    ServerConfig    `yaml:"server" toml:"server"`
+	Storage   StorageConfig   `yaml:"storage" toml:"storage"`
+	Log       LogConfig       `yaml:"log" toml:"log"`
+	Scheduler SchedulerConfig `yaml:"scheduler" toml:"scheduler"`
+	Webhooks  WebhookConfig   `yaml:"webhooks" toml:"webhooks"`
+	Workflow  string          `yaml:"workflow" toml:"workflow"` // Optional JSON or YAML workflow definition
+	SeedUsers []SeedUser      `yaml:"seedUsers" toml:"seedUsers"`
+}
+
+// ServerConfig configures the HTTP listener. A zero read, write or idle timeout means no limit.
+type ServerConfig struct {
+	Addr            string   `yaml:"addr" toml:"addr"`
+	Framework       string   `yaml:"framework" toml:"framework"` // "gin" or "http"
+	ReadTimeout     Duration `yaml:"readTimeout" toml:"readTimeout"`
+	WriteTimeout    Duration `yaml:"writeTimeout" toml:"writeTimeout"`
+	IdleTimeout     Duration `yaml:"idleTimeout" toml:"idleTimeout"`
+	ShutdownTimeout Duration `yaml:"shutdownTimeout" toml:"shutdownTimeout"`
+}
+
+// StorageConfig selects the repository backend
+type StorageConfig struct {
+	Backend string `yaml:"backend" toml:"backend"` // "memory" or "file"
+	DataDir string `yaml:"dataDir" toml:"dataDir"`
+}
+
+// LogConfig controls logging verbosity
+type LogConfig struct {
+	Level string `yaml:"level" toml:"level"`
+}
+
+// SchedulerConfig controls the background jobs run inside the server process
+type SchedulerConfig struct {
+	// OverdueInterval is how often issues are checked for passed due dates; zero disables the check
+	OverdueInterval Duration `yaml:"overdueInterval" toml:"overdueInterval"`
+	// WebhookRetryInterval is how often failed webhook deliveries due for a retry are resent; zero disables retries
+	WebhookRetryInterval Duration `yaml:"webhookRetryInterval" toml:"webhookRetryInterval"`
+}
+
+// WebhookConfig controls outgoing webhook deliveries
+type WebhookConfig struct {
+	MaxAttempts int      `yaml:"maxAttempts" toml:"maxAttempts"` // attempts before a delivery is dead-lettered
+	Timeout     Duration `yaml:"timeout" toml:"timeout"`         // limit for a single attempt
+	Backoff     Duration `yaml:"backoff" toml:"backoff"`         // delay before the first retry, doubled for each further retry
+}
+
+// SeedUser is a user created when the store holds no users yet
+type SeedUser struct {
+	Name  string `yaml:"name" toml:"name"`
+	Email string `yaml:"email" toml:"email"`
+	Role  string `yaml:"role" toml:"role"` // admin or member; empty means member
+}
+
+// Duration is a time.Duration written as a Go duration string ("30s", "1m30s") in config files
+type Duration time.Duration
+
+// UnmarshalText parses a duration string
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// MarshalText formats the duration as a string
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// Default returns the configuration used when nothing is overridden
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Addr:            ":8080",
+			Framework:       serverPkg.FrameworkGin,
+			ReadTimeout:     Duration(15 * time.Second),
+			WriteTimeout:    0, // unlimited by default so long-running responses are not cut off
+			IdleTimeout:     Duration(60 * time.Second),
+			ShutdownTimeout: Duration(30 * time.Second),
+		},
+		Storage: StorageConfig{
+			Backend: repository.BackendMemory,
+			DataDir: "data",
+		},
+		Log: LogConfig{
+			Level: LogLevelInfo,
+		},
+		Scheduler: SchedulerConfig{
+			OverdueInterval:      Duration(time.Minute),
+			WebhookRetryInterval: Duration(5 * time.Second),
+		},
+		Webhooks: WebhookConfig{
+			MaxAttempts: 6,
+			Timeout:     Duration(10 * time.Second),
+			Backoff:     Duration(30 * time.Second),
+		},
+		SeedUsers: defaultSeedUsers(),
+	}
+}
+
+// defaultSeedUsers returns the users seeded by the services when none are configured
+func defaultSeedUsers() []SeedUser {
+	defaults := domain.DefaultSeedUsers()
+	users := make([]SeedUser, len(defaults))
+	for i, user := range defaults {
+		users[i] = SeedUser{Name: user.Name, Email: user.Email, Role: user.Role}
+	}
+	return users
+}
+
+// Validate checks the configuration and reports every problem found
+func (c *Config) Validate() error {
+	var errs []error
+	fail := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if _, _, err := net.SplitHostPort(c.Server.Addr); err != nil {
+		fail("server.addr: invalid listen address %q", c.Server.Addr)
+	}
+	switch c.Server.Framework {
+	case serverPkg.FrameworkGin, serverPkg.FrameworkStandard:
+	default:
+		fail("server.framework: unknown framework %q", c.Server.Framework)
+	}
+	durations := []struct {
+		name  string
+		value Duration
+	}{
+		{"server.readTimeout", c.Server.ReadTimeout},
+		{"server.writeTimeout", c.Server.WriteTimeout},
+		{"server.idleTimeout", c.Server.IdleTimeout},
+		{"server.shutdownTimeout", c.Server.ShutdownTimeout},
+		{"scheduler.overdueInterval", c.Scheduler.OverdueInterval},
+		{"scheduler.webhookRetryInterval", c.Scheduler.WebhookRetryInterval},
+		{"webhooks.timeout", c.Webhooks.Timeout},
+		{"webhooks.backoff", c.Webhooks.Backoff},
+	}
+	for _, duration := range durations {
+		if duration.value < 0 {
+			fail("%s: must not be negative", duration.name)
+		}
+	}
+	if c.Server.ShutdownTimeout == 0 {
+		fail("server.shutdownTimeout: must be positive")
+	}
+	if c.Webhooks.MaxAttempts < 1 {
+		fail("webhooks.maxAttempts: must be at least 1")
+	}
+	if c.Webhooks.Timeout == 0 {
+		fail("webhooks.timeout: must be positive")
+	}
+
+	switch c.Storage.Backend {
+	case repository.BackendMemory:
+	case repository.BackendFile:
+		if strings.TrimSpace(c.Storage.DataDir) == "" {
+			fail("storage.dataDir: required for the file backend")
+		}
+	default:
+		fail("storage.backend: unknown backend %q", c.Storage.Backend)
+	}
+
+	switch c.Log.Level {
+	case LogLevelDebug, LogLevelInfo, LogLevelWarn, LogLevelError:
+	default:
+		fail("log.level: unknown level %q", c.Log.Level)
+	}
+
+	emails := make(map[string]bool)
+	for i, user := range c.SeedUsers {
+		if strings.TrimSpace(user.Name) == "" {
+			fail("seedUsers[%d].name: required", i)
+		}
+		if addr, err := mail.ParseAddress(user.Email); err != nil || addr.Address != user.Email {
+			fail("seedUsers[%d].email: invalid email %q", i, user.Email)
+		}
+		key := strings.ToLower(user.Email)
+		if emails[key] {
+			fail("seedUsers[%d].email: duplicate email %q", i, user.Email)
+		}
+		emails[key] = true
+		switch user.Role {
+		case "", domain.UserRoleAdmin, domain.UserRoleMember:
+		default:
+			fail("seedUsers[%d].role: unknown role %q", i, user.Role)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// IsDebug reports whether debug logging is enabled
+func (c *Config) IsDebug() bool {
+	return c.Log.Level == LogLevelDebug
+}
+
+// LogsRequests reports whether per-request access logs are written
+func (c *Config) LogsRequests() bool {
+	return c.Log.Level == LogLevelDebug || c.Log.Level == LogLevelInfo
+}
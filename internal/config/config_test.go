@@ -0,0 +1,157 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeFile writes a config file into a temporary directory and returns its path
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	return path
+}
+
+// noEnv is a lookup function without any environment variables
+func noEnv(string) (string, bool) { return "", false }
+
+// TestDefaultIsValid tests that the built-in defaults pass validation
+func TestDefaultIsValid(t *testing.T) {
+	if err := Default().Validate(); err != nil {
+		t.Errorf("Expected default config to be valid, got %v", err)
+	}
+}
+
+// TestLoadFileFormats tests loading YAML and TOML files over the defaults
+func TestLoadFileFormats(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{"YAML", "config.yaml", `
+server:
+  addr: ":9090"
+  shutdownTimeout: 10s
+storage:
+  backend: file
+  dataDir: /var/lib/aoroa
+seedUsers:
+  - name: Admin
+    email: admin@example.com
+`},
+		{"TOML", "config.toml", `
+seedUsers = [{ name = "Admin", email = "admin@example.com" }]
+
+[server]
+addr = ":9090"
+shutdownTimeout = "10s"
+
+[storage]
+backend = "file"
+dataDir = "/var/lib/aoroa"
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := Load(writeFile(t, tt.file, tt.content), noEnv)
+			if err != nil {
+				t.Fatalf("Load failed: %v", err)
+			}
+			if cfg.Server.Addr != ":9090" || time.Duration(cfg.Server.ShutdownTimeout) != 10*time.Second {
+				t.Errorf("Unexpected server config %+v", cfg.Server)
+			}
+			if cfg.Storage.Backend != "file" || cfg.Storage.DataDir != "/var/lib/aoroa" {
+				t.Errorf("Unexpected storage config %+v", cfg.Storage)
+			}
+			if len(cfg.SeedUsers) != 1 || cfg.SeedUsers[0].Email != "admin@example.com" {
+				t.Errorf("Expected seed users to be replaced, got %+v", cfg.SeedUsers)
+			}
+			// Values absent from the file keep their defaults
+			if cfg.Server.Framework != Default().Server.Framework || cfg.Log.Level != LogLevelInfo {
+				t.Errorf("Expected defaults for unset values, got %+v %+v", cfg.Server, cfg.Log)
+			}
+		})
+	}
+}
+
+// TestLoadRejectsUnknownKeys tests that typos and unsupported formats are reported
+func TestLoadRejectsUnknownKeys(t *testing.T) {
+	if _, err := Load(writeFile(t, "config.yaml", "server:\n  adress: \":9090\"\n"), noEnv); err == nil {
+		t.Error("Expected error for unknown key")
+	}
+	if _, err := Load(writeFile(t, "config.ini", "addr=:9090"), noEnv); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
+
+// TestOverridePrecedence tests that flags override environment variables, which override the file
+func TestOverridePrecedence(t *testing.T) {
+	path := writeFile(t, "config.yaml", "server:\n  addr: \":9090\"\nlog:\n  level: warn\n")
+	env := map[string]string{
+		"AOROA_SERVER_ADDR":          ":7070",
+		"AOROA_LOG_LEVEL":            "debug",
+		"AOROA_SEED_USERS":           "Admin <admin@example.com> admin; Ops <ops@example.com>",
+		"AOROA_WEBHOOK_MAX_ATTEMPTS": "3",
+	}
+	cfg, err := Load(path, func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	})
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := BindFlags(fs)
+	if err := fs.Parse([]string{"-addr", ":6060", "-read-timeout", "5s"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if err := flags.Apply(cfg); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	if cfg.Server.Addr != ":6060" {
+		t.Errorf("Expected flag to win over environment, got %s", cfg.Server.Addr)
+	}
+	if cfg.Log.Level != LogLevelDebug {
+		t.Errorf("Expected environment to win over file, got %s", cfg.Log.Level)
+	}
+	if time.Duration(cfg.Server.ReadTimeout) != 5*time.Second {
+		t.Errorf("Expected read timeout from flag, got %v", time.Duration(cfg.Server.ReadTimeout))
+	}
+	if cfg.Webhooks.MaxAttempts != 3 {
+		t.Errorf("Expected webhook attempts from environment, got %d", cfg.Webhooks.MaxAttempts)
+	}
+	if len(cfg.SeedUsers) != 2 || cfg.SeedUsers[1].Name != "Ops" || cfg.SeedUsers[1].Email != "ops@example.com" || cfg.SeedUsers[0].Role != "admin" {
+		t.Errorf("Unexpected seed users %+v", cfg.SeedUsers)
+	}
+}
+
+// TestValidateReportsAllProblems tests that validation lists every invalid setting
+func TestValidateReportsAllProblems(t *testing.T) {
+	cfg := Default()
+	cfg.Server.Addr = "nope"
+	cfg.Server.IdleTimeout = Duration(-time.Second)
+	cfg.Storage.Backend = "postgres"
+	cfg.Webhooks.MaxAttempts = 0
+	cfg.SeedUsers = append(cfg.SeedUsers, SeedUser{Name: "Clone", Email: "KIM@example.com", Role: "owner"})
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Expected validation error")
+	}
+	for _, want := range []string{"server.addr", "server.idleTimeout", "storage.backend", "webhooks.maxAttempts", "seedUsers[3].email", "seedUsers[3].role"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to mention %s, got %v", want, err)
+		}
+	}
+}
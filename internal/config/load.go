@@ -0,0 +1,192 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix prefixes every environment variable read by the configuration
+const EnvPrefix = "AOROA_"
+
+// ConfigFileEnv names the environment variable holding the config file path
+const ConfigFileEnv = EnvPrefix + "CONFIG"
+
+// setting is a single scalar option that can be overridden by environment variable and flag
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	set   func(c *Config, value string) error
+}
+
+// settings lists every option overridable from the environment and the command line
+var settings = []setting{
+	{"SERVER_ADDR", "addr", "수신 주소 (예: :8080)", setString(func(c *Config) *string { return &c.Server.Addr })},
+	{"SERVER_FRAMEWORK", "framework", "웹 프레임워크 (gin | http)", setString(func(c *Config) *string { return &c.Server.Framework })},
+	{"SERVER_READ_TIMEOUT", "read-timeout", "요청 읽기 타임아웃 (예: 15s)", setDuration(func(c *Config) *Duration { return &c.Server.ReadTimeout })},
+	{"SERVER_WRITE_TIMEOUT", "write-timeout", "응답 쓰기 타임아웃 (예: 15s)", setDuration(func(c *Config) *Duration { return &c.Server.WriteTimeout })},
+	{"SERVER_IDLE_TIMEOUT", "idle-timeout", "keep-alive 유휴 타임아웃 (예: 60s)", setDuration(func(c *Config) *Duration { return &c.Server.IdleTimeout })},
+	{"SERVER_SHUTDOWN_TIMEOUT", "shutdown-timeout", "그레이스풀 셧다운 타임아웃 (예: 30s)", setDuration(func(c *Config) *Duration { return &c.Server.ShutdownTimeout })},
+	{"STORAGE_BACKEND", "storage", "저장소 종류 (memory | file)", setString(func(c *Config) *string { return &c.Storage.Backend })},
+	{"STORAGE_DATA_DIR", "data-dir", "file 저장소의 데이터 디렉터리", setString(func(c *Config) *string { return &c.Storage.DataDir })},
+	{"LOG_LEVEL", "log-level", "로그 레벨 (debug | info | warn | error)", setString(func(c *Config) *string { return &c.Log.Level })},
+	{"SCHEDULER_OVERDUE_INTERVAL", "overdue-interval", "기한 초과 이슈 확인 주기 (예: 1m, 0이면 사용 안 함)", setDuration(func(c *Config) *Duration { return &c.Scheduler.OverdueInterval })},
+	{"SCHEDULER_WEBHOOK_RETRY_INTERVAL", "webhook-retry-interval", "웹훅 재전송 확인 주기 (예: 5s, 0이면 재전송 안 함)", setDuration(func(c *Config) *Duration { return &c.Scheduler.WebhookRetryInterval })},
+	{"WEBHOOK_MAX_ATTEMPTS", "webhook-max-attempts", "웹훅 전송 최대 시도 횟수 (초과 시 dead 처리)", setInt(func(c *Config) *int { return &c.Webhooks.MaxAttempts })},
+	{"WEBHOOK_TIMEOUT", "webhook-timeout", "웹훅 전송 1회 타임아웃 (예: 10s)", setDuration(func(c *Config) *Duration { return &c.Webhooks.Timeout })},
+	{"WEBHOOK_BACKOFF", "webhook-backoff", "웹훅 첫 재전송 대기 시간, 이후 두 배씩 증가 (예: 30s)", setDuration(func(c *Config) *Duration { return &c.Webhooks.Backoff })},
+	{"WORKFLOW", "workflow", "워크플로 정의 파일 (JSON 또는 YAML, 미지정 시 기본 워크플로)", setString(func(c *Config) *string { return &c.Workflow })},
+	{"SEED_USERS", "seed-users", `초기 사용자 목록 ("이름 <email> [역할]"을 ';'로 구분)`, setSeedUsers},
+}
+
+// Load builds the configuration from defaults, the optional config file (YAML or TOML)
+// and AOROA_* environment variables, in increasing precedence.
+// lookupEnv is usually os.LookupEnv. The result is not validated.
+func Load(path string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range settings {
+		value, ok := lookupEnv(EnvPrefix + s.env)
+		if !ok {
+			continue
+		}
+		if err := s.set(cfg, value); err != nil {
+			return nil, fmt.Errorf("%s%s: %w", EnvPrefix, s.env, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// loadFile overlays the values of a YAML or TOML file; unknown keys are rejected
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(c)
+		if errors.Is(err, io.EOF) {
+			err = nil // empty file
+		}
+	case ".toml":
+		decoder := toml.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(c)
+	default:
+		return fmt.Errorf("unsupported config file format %q", filepath.Ext(path))
+	}
+	if err != nil {
+		return fmt.Errorf("parse config %s: %w", path, err)
+	}
+	return nil
+}
+
+// Flags collects command-line overrides; only flags given explicitly are applied
+type Flags struct {
+	values map[string]string
+}
+
+// BindFlags registers a flag for every overridable setting on fs
+func BindFlags(fs *flag.FlagSet) *Flags {
+	f := &Flags{values: make(map[string]string)}
+	for _, s := range settings {
+		name := s.flag
+		fs.Func(name, s.usage, func(value string) error {
+			f.values[name] = value
+			return nil
+		})
+	}
+	return f
+}
+
+// Apply overrides cfg with the flags given on the command line
+func (f *Flags) Apply(cfg *Config) error {
+	for _, s := range settings {
+		value, ok := f.values[s.flag]
+		if !ok {
+			continue
+		}
+		if err := s.set(cfg, value); err != nil {
+			return fmt.Errorf("-%s: %w", s.flag, err)
+		}
+	}
+	return nil
+}
+
+// setString returns a setter assigning the raw value to a string option
+func setString(field func(c *Config) *string) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		*field(c) = value
+		return nil
+	}
+}
+
+// setDuration returns a setter parsing the value into a duration option
+func setDuration(field func(c *Config) *Duration) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = Duration(d)
+		return nil
+	}
+}
+
+// setInt returns a setter parsing the value into an integer option
+func setInt(field func(c *Config) *int) func(c *Config, value string) error {
+	return func(c *Config, value string) error {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*field(c) = n
+		return nil
+	}
+}
+
+// setSeedUsers parses a ';'-separated list of "Name <email>" addresses,
+// each optionally followed by a role
+func setSeedUsers(c *Config, value string) error {
+	var users []SeedUser
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, rest, ok := strings.Cut(entry, "<")
+		email, role, closed := strings.Cut(rest, ">")
+		if !ok || !closed {
+			return fmt.Errorf("invalid seed user %q, expected \"Name <email> [role]\"", entry)
+		}
+		users = append(users, SeedUser{
+			Name:  strings.TrimSpace(name),
+			Email: strings.TrimSpace(email),
+			Role:  strings.TrimSpace(role),
+		})
+	}
+	c.SeedUsers = users
+	return nil
+}
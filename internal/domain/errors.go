@@ -0,0 +1,191 @@
+package domain
+
+import "strings"
+
+// ErrorKind classifies a domain error; the handler layer maps each kind to an HTTP status
+type ErrorKind string
+
+// Error kinds
+const (
+	KindNotFound   ErrorKind = "NOT_FOUND"
+	KindConflict   ErrorKind = "CONFLICT"
+	KindValidation ErrorKind = "VALIDATION"
+	KindForbidden  ErrorKind = "FORBIDDEN"
+	// KindPreconditionFailed marks a request made against an outdated version, e.g. a failed If-Match
+	KindPreconditionFailed ErrorKind = "PRECONDITION_FAILED"
+)
+
+// Error is a typed domain error with a machine-readable code.
+// Two errors match with errors.Is when their codes are equal, so sentinel
+// errors below can be compared even after details were attached.
+type Error struct {
+	Kind    ErrorKind
+	Code    string
+	Message string
+	Details map[string]interface{}
+	Fields  []FieldError
+}
+
+// FieldError describes why a single request field was rejected
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error returns the human-readable message
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is a domain error with the same code
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+// WithDetail returns a copy of the error with an additional detail
+func (e *Error) WithDetail(key string, value interface{}) *Error {
+	clone := *e
+	clone.Details = make(map[string]interface{}, len(e.Details)+1)
+	for k, v := range e.Details {
+		clone.Details[k] = v
+	}
+	clone.Details[key] = value
+	return &clone
+}
+
+// NotFound creates an error for a missing resource
+func NotFound(code, message string) *Error {
+	return &Error{Kind: KindNotFound, Code: code, Message: message}
+}
+
+// Conflict creates an error for a request that violates a business rule
+func Conflict(code, message string) *Error {
+	return &Error{Kind: KindConflict, Code: code, Message: message}
+}
+
+// Validation creates an error for malformed or invalid input
+func Validation(code, message string) *Error {
+	return &Error{Kind: KindValidation, Code: code, Message: message}
+}
+
+// Forbidden creates an error for an action the caller may not perform
+func Forbidden(code, message string) *Error {
+	return &Error{Kind: KindForbidden, Code: code, Message: message}
+}
+
+// PreconditionFailed creates an error for a request made against an outdated version of a resource
+func PreconditionFailed(code, message string) *Error {
+	return &Error{Kind: KindPreconditionFailed, Code: code, Message: message}
+}
+
+// FieldValidation creates a validation error about a single request field
+func FieldValidation(code, field, message string) *Error {
+	err := Validation(code, message).WithDetail("field", field)
+	err.Fields = []FieldError{{Field: field, Code: code, Message: message}}
+	return err
+}
+
+// JoinValidation combines field validation errors into one error listing every field.
+// It returns nil when errs is empty and the error itself when there is only one.
+func JoinValidation(errs ...*Error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+
+	messages := make([]string, 0, len(errs))
+	joined := Validation(ErrValidationFailed.Code, "")
+	for _, err := range errs {
+		messages = append(messages, err.Message)
+		joined.Fields = append(joined.Fields, err.Fields...)
+	}
+	joined.Message = strings.Join(messages, "; ")
+	return joined
+}
+
+// Not found errors
+var (
+	ErrIssueNotFound       = NotFound("issue_not_found", "issue not found")
+	ErrUserNotFound        = NotFound("user_not_found", "user not found")
+	ErrCommentNotFound     = NotFound("comment_not_found", "comment not found")
+	ErrProjectNotFound     = NotFound("project_not_found", "project not found")
+	ErrLabelNotFound       = NotFound("label_not_found", "label not found")
+	ErrParentNotFound      = NotFound("parent_not_found", "parent issue not found")
+	ErrLinkNotFound        = NotFound("link_not_found", "issue link not found")
+	ErrLinkedIssueNotFound = NotFound("linked_issue_not_found", "linked issue not found")
+	ErrWebhookNotFound     = NotFound("webhook_not_found", "webhook not found")
+)
+
+// Conflict errors
+var (
+	ErrIssueFinalState        = Conflict("issue_final_state", "cannot update issue in final state")
+	ErrCommentOnFinalIssue    = Conflict("comment_on_final_issue", "cannot comment on issue in final state")
+	ErrTransitionNotAllowed   = Conflict("transition_not_allowed", "status transition not allowed")
+	ErrAssigneeRequired       = Conflict("assignee_required", "status requires an assignee")
+	ErrDescriptionRequired    = Conflict("description_required", "status requires a description")
+	ErrEmailInUse             = Conflict("email_in_use", "email already in use")
+	ErrUserDeactivated        = Conflict("user_deactivated", "user is deactivated")
+	ErrUserAlreadyDeactivated = Conflict("user_already_deactivated", "user already deactivated")
+	ErrProjectKeyInUse        = Conflict("project_key_in_use", "project key already in use")
+	ErrAssigneeNotMember      = Conflict("assignee_not_member", "assignee is not a member of the project")
+	ErrAlreadyProjectMember   = Conflict("already_project_member", "user is already a member of the project")
+	ErrLabelNameInUse         = Conflict("label_name_in_use", "label name already in use")
+	ErrP0RequiresAssignee     = Conflict("p0_requires_assignee", "P0 issues must have an assignee")
+	ErrParentCycle            = Conflict("parent_cycle", "issue cannot be its own ancestor")
+	ErrParentClosed           = Conflict("parent_closed", "parent issue is in a final state")
+	ErrParentProjectMismatch  = Conflict("parent_project_mismatch", "parent issue belongs to a different project")
+	ErrOpenSubTasks           = Conflict("open_subtasks", "issue has open sub-tasks")
+	ErrLinkExists             = Conflict("link_exists", "issues are already linked")
+	ErrBlockingCycle          = Conflict("blocking_cycle", "issue would transitively block itself")
+	ErrBlockedByOpenIssue     = Conflict("blocked_by_open_issue", "issue is blocked by open issues")
+)
+
+// Precondition errors
+var (
+	ErrIssueModified = PreconditionFailed("issue_modified", "issue has been modified since it was read")
+)
+
+// Forbidden errors
+var (
+	ErrNotCommentAuthor = Forbidden("not_comment_author", "only the author can modify a comment")
+	ErrAdminRequired    = Forbidden("admin_required", "admin role required")
+	ErrNotProjectMember = Forbidden("not_project_member", "user is not a member of the project")
+	ErrNotSelfOrAdmin   = Forbidden("not_self_or_admin", "only the user or an admin can change a user")
+	ErrActorDeactivated = Forbidden("actor_deactivated", "acting user is deactivated")
+)
+
+// Validation errors
+var (
+	ErrValidationFailed      = Validation("validation_failed", "validation failed")
+	ErrInvalidStatus         = Validation("invalid_status", "invalid status")
+	ErrInvalidSortField      = Validation("invalid_sort_field", "invalid sort field")
+	ErrInvalidSortOrder      = Validation("invalid_sort_order", "invalid sort order")
+	ErrInvalidCursor         = Validation("invalid_cursor", "invalid cursor")
+	ErrInvalidLimit          = Validation("invalid_limit", "invalid limit")
+	ErrInvalidSince          = FieldValidation("invalid_since", "since", "since must be a change sequence number")
+	ErrSinceAhead            = FieldValidation("since_ahead", "since", "since is after the latest change; sync again from 0")
+	ErrInvalidDateRange      = Validation("invalid_date_range", "invalid date range")
+	ErrInvalidLabelMatch     = Validation("invalid_label_match", "invalid label match")
+	ErrSearchQueryRequired   = FieldValidation("search_query_required", "q", "search query is required")
+	ErrNameRequired          = FieldValidation("name_required", "name", "name is required")
+	ErrEmailRequired         = FieldValidation("email_required", "email", "email is required")
+	ErrInvalidEmail          = FieldValidation("invalid_email", "email", "invalid email")
+	ErrCommentBodyRequired   = FieldValidation("comment_body_required", "body", "comment body is required")
+	ErrInvalidProjectKey     = FieldValidation("invalid_project_key", "key", "project key must be 2-10 uppercase letters or digits starting with a letter")
+	ErrProjectNameRequired   = FieldValidation("project_name_required", "name", "project name is required")
+	ErrLabelNameRequired     = FieldValidation("label_name_required", "name", "label name is required")
+	ErrInvalidLabelName      = FieldValidation("invalid_label_name", "name", "label name must not contain commas")
+	ErrDueBeforeStart        = FieldValidation("due_before_start", "dueDate", "due date must not be before start date")
+	ErrInvalidPriority       = FieldValidation("invalid_priority", "priority", "priority must be one of P0, P1, P2, P3, P4")
+	ErrInvalidSeverity       = FieldValidation("invalid_severity", "severity", "severity must be one of critical, major, minor, trivial")
+	ErrInvalidLinkType       = FieldValidation("invalid_link_type", "type", "link type must be one of blocks, blocked_by, duplicates, duplicated_by, relates_to")
+	ErrSelfLink              = FieldValidation("self_link", "issueId", "issue cannot be linked to itself")
+	ErrInvalidWebhookURL     = FieldValidation("invalid_webhook_url", "url", "webhook URL must be an absolute http or https URL")
+	ErrWebhookSecretRequired = FieldValidation("webhook_secret_required", "secret", "webhook secret is required")
+	ErrInvalidWebhookEvent   = FieldValidation("invalid_webhook_event", "events", "unknown event topic")
+	ErrInvalidLabelColor     = FieldValidation("invalid_label_color", "color", "label color must be a hex color such as #d73a4a")
+)
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 // IssueStatus constants
 const (
 	StatusPending    = "PENDING"
@@ -18,26 +20,360 @@ func IsValidStatus(status string) bool {
 	}
 }
 
+// User roles
+const (
+	UserRoleAdmin  = "admin"
+	UserRoleMember = "member"
+)
+
+// IsValidUserRole checks if the given user role is valid
+func IsValidUserRole(role string) bool {
+	return role == UserRoleAdmin || role == UserRoleMember
+}
+
+// DefaultSeedUsers returns the users created in an empty store when no others are configured
+func DefaultSeedUsers() []CreateUserRequest {
+	return []CreateUserRequest{
+		{Name: "김개발", Email: "kim@example.com", Role: UserRoleAdmin},
+		{Name: "이디자인", Email: "lee@example.com", Role: UserRoleMember},
+		{Name: "박기획", Email: "park@example.com", Role: UserRoleMember},
+	}
+}
+
+// Issue priorities, from most to least urgent
+const (
+	PriorityP0 = "P0"
+	PriorityP1 = "P1"
+	PriorityP2 = "P2"
+	PriorityP3 = "P3"
+	PriorityP4 = "P4"
+
+	// PriorityDefault is given to issues created without a priority
+	PriorityDefault = PriorityP2
+)
+
+// IsValidPriority checks if the given priority is valid
+func IsValidPriority(priority string) bool {
+	switch priority {
+	case PriorityP0, PriorityP1, PriorityP2, PriorityP3, PriorityP4:
+		return true
+	default:
+		return false
+	}
+}
+
+// Issue severities, from most to least severe. Severity is optional.
+const (
+	SeverityCritical = "critical"
+	SeverityMajor    = "major"
+	SeverityMinor    = "minor"
+	SeverityTrivial  = "trivial"
+)
+
+// IsValidSeverity checks if the given severity is valid
+func IsValidSeverity(severity string) bool {
+	switch severity {
+	case SeverityCritical, SeverityMajor, SeverityMinor, SeverityTrivial:
+		return true
+	default:
+		return false
+	}
+}
+
+// Issue link types. Each directional type has an inverse naming the same link
+// from the other issue's side; relates_to is symmetric.
+const (
+	LinkBlocks       = "blocks"
+	LinkBlockedBy    = "blocked_by"
+	LinkDuplicates   = "duplicates"
+	LinkDuplicatedBy = "duplicated_by"
+	LinkRelatesTo    = "relates_to"
+)
+
+// InverseLinkType returns the link type as seen from the other issue
+func InverseLinkType(linkType string) string {
+	switch linkType {
+	case LinkBlocks:
+		return LinkBlockedBy
+	case LinkBlockedBy:
+		return LinkBlocks
+	case LinkDuplicates:
+		return LinkDuplicatedBy
+	case LinkDuplicatedBy:
+		return LinkDuplicates
+	default:
+		return linkType
+	}
+}
+
+// IsValidLinkType checks if the given link type is valid
+func IsValidLinkType(linkType string) bool {
+	switch linkType {
+	case LinkBlocks, LinkBlockedBy, LinkDuplicates, LinkDuplicatedBy, LinkRelatesTo:
+		return true
+	default:
+		return false
+	}
+}
+
+// Webhook delivery states. A pending delivery is retried until it is delivered
+// or runs out of attempts and is dead-lettered.
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryDead      = "dead"
+)
+
+// Changes feed entities and operations
+const (
+	ChangeEntityIssue = "issue"
+	ChangeEntityLink  = "issueLink"
+
+	ChangeCreated = "created"
+	ChangeUpdated = "updated"
+	ChangeDeleted = "deleted"
+)
+
+// Changes feed page sizes
+const (
+	DefaultChangesLimit = 100
+	MaxChangesLimit     = 1000
+)
+
+// Issue list sort fields
+const (
+	SortByID        = "id"
+	SortByCreatedAt = "createdAt"
+	SortByUpdatedAt = "updatedAt"
+	SortByTitle     = "title"
+	SortByStatus    = "status"
+	SortByPriority  = "priority"
+)
+
+// Sort orders
+const (
+	SortAscending  = "asc"
+	SortDescending = "desc"
+)
+
+// IsValidSortField checks if issues can be sorted by the given field
+func IsValidSortField(field string) bool {
+	switch field {
+	case SortByID, SortByCreatedAt, SortByUpdatedAt, SortByTitle, SortByStatus, SortByPriority:
+		return true
+	default:
+		return false
+	}
+}
+
+// IssueFilter selects issues by their fields. Empty fields do not filter;
+// all non-empty fields must match.
+type IssueFilter struct {
+	Statuses      []string   // Issue status is any of these
+	AssigneeIDs   []uint     // Issue is assigned to any of these users
+	Unassigned    bool       // Issue has no assignee; combined with AssigneeIDs as "any of"
+	CreatedAfter  *time.Time // Inclusive lower bound of CreatedAt
+	CreatedBefore *time.Time // Exclusive upper bound of CreatedAt
+	UpdatedAfter  *time.Time // Inclusive lower bound of UpdatedAt
+	UpdatedBefore *time.Time // Exclusive upper bound of UpdatedAt
+	Text          string     // Case-insensitive substring of title or description
+	ProjectKeys   []string   // Issue belongs to any of these projects (case-insensitive keys)
+	Labels        []string   // Issue carries these labels (case-insensitive names)
+	LabelMatch    string     // all (default) requires every label in Labels, any at least one
+	Overdue       *bool      // Issue is (true) or is not (false) open past its due date
+}
+
+// Label filter matching modes
+const (
+	LabelMatchAll = "all"
+	LabelMatchAny = "any"
+)
+
+// IssueListQuery describes which page of issues to list and in what order
+type IssueListQuery struct {
+	Filter IssueFilter
+	Sort   string // One of the SortBy* fields; defaults to id
+	Order  string // asc or desc; defaults to asc
+	Limit  int    // Maximum number of issues to return; zero means no limit
+	Cursor string // Opaque cursor returned as NextCursor by the previous page
+	// ActorID is the user listing issues. Non-admin users only see issues of
+	// projects they belong to and issues created outside any project.
+	ActorID *uint
+}
+
+// ChangesQuery describes which page of the changes feed to read
+type ChangesQuery struct {
+	Since uint // Return changes after this sequence number; zero reads from the start
+	Limit int  // Maximum number of changes to read; zero uses DefaultChangesLimit
+	// ActorID is the user syncing. Like the issue list, non-admin users skip
+	// changes of issues in projects they do not belong to.
+	ActorID *uint
+}
+
 // CreateUserRequest represents the request payload for creating a user
 type CreateUserRequest struct {
 	Name  string `json:"name" binding:"required"`
 	Email string `json:"email" binding:"required"`
+	Role  string `json:"-"` // Only set for configured seed users; users created through the API are members
+}
+
+// UpdateUserRequest represents the request payload for updating a user
+type UpdateUserRequest struct {
+	Name  *string `json:"name,omitempty"`
+	Email *string `json:"email,omitempty"`
+}
+
+// UsersResponse represents the response for listing users
+type UsersResponse struct {
+	Users []interface{} `json:"users"` // Will be []*models.User
 }
 
 // CreateIssueRequest represents the request payload for creating an issue
 type CreateIssueRequest struct {
-	Title       string `json:"title" binding:"required"`
-	Description string `json:"description"`
-	UserID      *uint  `json:"userId,omitempty"`
+	Title       string     `json:"title" binding:"required"`
+	Description string     `json:"description"`
+	UserID      *uint      `json:"userId,omitempty"`
+	Priority    string     `json:"priority,omitempty"` // P0-P4; defaults to P2
+	Severity    string     `json:"severity,omitempty"` // critical, major, minor or trivial; optional
+	StartDate   *time.Time `json:"startDate,omitempty"`
+	DueDate     *time.Time `json:"dueDate,omitempty"`
+	ProjectKey  string     `json:"projectKey,omitempty"` // Project the issue is numbered in; empty for none
+	ParentID    *uint      `json:"parentId,omitempty"`   // Makes the issue a sub-task of this issue
+	Labels      []string   `json:"labels,omitempty"`     // Names of existing labels to attach
+	ActorID     *uint      `json:"-"`                    // User performing the request, recorded in the issue history
 }
 
 // UpdateIssueRequest represents the request payload for updating an issue
 type UpdateIssueRequest struct {
-	Title       *string `json:"title,omitempty"`
+	Title        *string    `json:"title,omitempty"`
+	Description  *string    `json:"description,omitempty"`
+	Status       *string    `json:"status,omitempty"`
+	UserID       *uint      `json:"userId,omitempty"`
+	RemoveUser   bool       `json:"-"` // Internal flag for removing user
+	Priority     *string    `json:"priority,omitempty"`
+	Severity     *string    `json:"severity,omitempty"` // An empty severity clears it
+	StartDate    *time.Time `json:"startDate,omitempty"`
+	DueDate      *time.Time `json:"dueDate,omitempty"`
+	ParentID     *uint      `json:"parentId,omitempty"`
+	RemoveParent bool       `json:"-"` // Internal flag for detaching a sub-task from its parent
+	// RemoveStartDate and RemoveDueDate clear the dates (internal flags set for null values)
+	RemoveStartDate bool `json:"-"`
+	RemoveDueDate   bool `json:"-"`
+	// Labels replaces the issue's labels by name when set; an empty list removes them all
+	Labels  *[]string `json:"labels,omitempty"`
+	ActorID *uint     `json:"-"` // User performing the request, recorded in the issue history
+	// IfMatch lists the versions the client expects the issue to be at, from
+	// the If-Match header; nil skips the check and an empty list never matches
+	IfMatch []uint `json:"-"`
+}
+
+// CreateProjectRequest represents the request payload for creating a project
+type CreateProjectRequest struct {
+	Key         string `json:"key" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	MemberIDs   []uint `json:"memberIds"`
+}
+
+// UpdateProjectRequest represents the request payload for updating a project.
+// The key cannot change because it is part of every issue key.
+type UpdateProjectRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// AddProjectMemberRequest represents the request payload for adding a project member
+type AddProjectMemberRequest struct {
+	UserID uint `json:"userId" binding:"required"`
+}
+
+// CreateLabelRequest represents the request payload for creating a label
+type CreateLabelRequest struct {
+	Name        string `json:"name" binding:"required"`
+	Color       string `json:"color"` // Hex color such as "#d73a4a"; a neutral gray when empty
+	Description string `json:"description"`
+}
+
+// UpdateLabelRequest represents the request payload for updating a label
+type UpdateLabelRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Color       *string `json:"color,omitempty"`
 	Description *string `json:"description,omitempty"`
-	Status      *string `json:"status,omitempty"`
-	UserID      *uint   `json:"userId,omitempty"`
-	RemoveUser  bool    `json:"-"` // Internal flag for removing user
+}
+
+// LabelsResponse represents the response for listing labels
+type LabelsResponse struct {
+	Labels []interface{} `json:"labels"` // Will be []*models.Label
+}
+
+// ProjectsResponse represents the response for listing projects
+type ProjectsResponse struct {
+	Projects []interface{} `json:"projects"` // Will be []*models.Project
+}
+
+// CreateIssueLinkRequest represents the request payload for linking an issue to another
+type CreateIssueLinkRequest struct {
+	Type    string `json:"type" binding:"required"`    // One of the Link* types, from the linking issue's side
+	IssueID uint   `json:"issueId" binding:"required"` // The other issue
+	ActorID *uint  `json:"-"`                          // User performing the request; needs access to both issues
+}
+
+// IssueLinksResponse represents the response for listing an issue's links
+type IssueLinksResponse struct {
+	Links []interface{} `json:"links"` // Will be []service.IssueLinkView
+}
+
+// CreateWebhookRequest represents the request payload for subscribing a webhook
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" binding:"required"`
+	Events []string `json:"events"` // Event topics to deliver; every event when empty
+	Secret string   `json:"secret" binding:"required"`
+	Active *bool    `json:"active,omitempty"` // Defaults to true
+}
+
+// UpdateWebhookRequest represents the request payload for updating a webhook
+type UpdateWebhookRequest struct {
+	URL    *string   `json:"url,omitempty"`
+	Events *[]string `json:"events,omitempty"`
+	Secret *string   `json:"secret,omitempty"`
+	Active *bool     `json:"active,omitempty"`
+}
+
+// WebhooksResponse represents the response for listing webhooks
+type WebhooksResponse struct {
+	Webhooks []interface{} `json:"webhooks"` // Will be []*models.Webhook
+}
+
+// WebhookDeliveriesResponse represents the response for a webhook's delivery log
+type WebhookDeliveriesResponse struct {
+	Deliveries []interface{} `json:"deliveries"` // Will be []*models.WebhookDelivery
+}
+
+// ChangesResponse represents one page of the changes feed
+type ChangesResponse struct {
+	Changes   []interface{} `json:"changes"`   // Will be []*models.Change
+	NextSince uint          `json:"nextSince"` // Sequence number to pass as since for the next page
+	HasMore   bool          `json:"hasMore"`   // More changes follow nextSince right now
+}
+
+// CreateCommentRequest represents the request payload for commenting on an issue
+type CreateCommentRequest struct {
+	Body string `json:"body" binding:"required"`
+}
+
+// UpdateCommentRequest represents the request payload for editing a comment
+type UpdateCommentRequest struct {
+	Body string `json:"body" binding:"required"`
+}
+
+// CommentsResponse represents the response for listing comments
+type CommentsResponse struct {
+	Comments []interface{} `json:"comments"` // Will be []*models.Comment
+}
+
+// HistoryResponse represents the response for an issue's change history
+type HistoryResponse struct {
+	History []interface{} `json:"history"` // Will be []*models.HistoryEntry
 }
 
 // IssueResponse represents the response for a single issue
@@ -57,11 +393,34 @@ type GetIssuesResponse struct {
 
 // IssuesResponse represents the response for listing issues
 type IssuesResponse struct {
-	Issues []interface{} `json:"issues"` // Will be []models.Issue
+	Issues     []interface{} `json:"issues"` // Will be []models.Issue
+	Total      int           `json:"total"`  // Number of issues matching the query across all pages
+	NextCursor string        `json:"nextCursor,omitempty"`
+	Next       string        `json:"next,omitempty"` // Link to the next page
+}
+
+// SearchResponse represents the response for an issue search
+type SearchResponse struct {
+	Query   string        `json:"query"`
+	Results []interface{} `json:"results"` // Will be []service.SearchResult, most relevant first
 }
 
 // ErrorResponse represents an error response
 type ErrorResponse struct {
-	Error string `json:"error"`
-	Code  int    `json:"code"`
+	Error     string                 `json:"error"`
+	Code      int                    `json:"code"`
+	ErrorCode string                 `json:"errorCode,omitempty"` // Machine-readable domain error code
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+// ProblemDetails is an RFC 7807 error response (application/problem+json)
+type ProblemDetails struct {
+	Type     string                 `json:"type"`
+	Title    string                 `json:"title"`
+	Status   int                    `json:"status"`
+	Detail   string                 `json:"detail,omitempty"`
+	Instance string                 `json:"instance,omitempty"`
+	Code     string                 `json:"code,omitempty"`    // Machine-readable domain error code
+	Errors   []FieldError           `json:"errors,omitempty"`  // Per-field validation errors
+	Details  map[string]interface{} `json:"details,omitempty"` // Additional error context
 }
@@ -1,6 +1,9 @@
 package domain
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestIsValidStatus(t *testing.T) {
 	tests := []struct {
@@ -54,3 +57,21 @@ func TestIsValidStatus(t *testing.T) {
 		})
 	}
 }
+
+// TestErrorMatchesByCode tests that domain errors with details still match their sentinel
+func TestErrorMatchesByCode(t *testing.T) {
+	err := ErrInvalidStatus.WithDetail("status", "UNKNOWN")
+
+	if !errors.Is(err, ErrInvalidStatus) {
+		t.Error("Expected error with details to match its sentinel")
+	}
+	if errors.Is(err, ErrInvalidSortField) {
+		t.Error("Expected errors with different codes not to match")
+	}
+	if err.Error() != "invalid status" || err.Kind != KindValidation {
+		t.Errorf("Unexpected error %q of kind %s", err.Error(), err.Kind)
+	}
+	if ErrInvalidStatus.Details != nil {
+		t.Error("Expected WithDetail to leave the sentinel unchanged")
+	}
+}
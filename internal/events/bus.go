@@ -0,0 +1,212 @@
+package events
+
+import (
+	"context"
+	"hash/fnv"
+	"log"
+	"sync"
+	"sync/atomic"
+)
+
+// asyncQueueSize is the number of events buffered per asynchronous worker.
+// Publishing blocks while a worker's queue is full.
+const asyncQueueSize = 256
+
+// Handler receives published events
+type Handler func(Event)
+
+// On adapts a handler for one event type; events of other types are ignored
+func On[E Event](handler func(E)) Handler {
+	return func(event Event) {
+		if e, ok := event.(E); ok {
+			handler(e)
+		}
+	}
+}
+
+// Bus delivers events to in-process subscribers.
+//
+// Synchronous subscribers run in the publishing goroutine before Publish returns;
+// asynchronous subscribers run on their own workers. Either way every subscriber
+// sees the events of one key in publication order, and a panicking subscriber is
+// logged without affecting the publisher or other subscribers. Handlers must not
+// publish or subscribe themselves.
+type Bus struct {
+	// issued is the sequence of the last reserved ticket. It is kept outside mu
+	// because tickets are reserved under the store's write lock while synchronous
+	// handlers, which run under mu, may be waiting to read the store.
+	issued atomic.Uint64
+
+	mu     sync.Mutex
+	turn   *sync.Cond // signalled when the next ticket may deliver
+	next   uint64     // sequence of the ticket allowed to deliver next
+	sync   []Handler
+	async  []*asyncSubscriber
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// asyncSubscriber spreads events over workers by key so that events of one key stay in order
+type asyncSubscriber struct {
+	handler Handler
+	queues  []chan Event
+}
+
+// Ticket reserves a place in the delivery order. Services reserve a ticket while
+// their change is being committed and publish through it afterwards, so events
+// are delivered in commit order even when the publishing goroutines race.
+// Every ticket must be published or cancelled, or later tickets wait forever.
+type Ticket struct {
+	bus *Bus
+	seq uint64
+}
+
+// NewBus creates a bus without subscribers
+func NewBus() *Bus {
+	b := &Bus{next: 1}
+	b.turn = sync.NewCond(&b.mu)
+	return b
+}
+
+// Subscribe registers a handler run synchronously for every published event
+func (b *Bus) Subscribe(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sync = append(b.sync, handler)
+}
+
+// SubscribeAsync registers a handler run on the given number of background
+// workers (at least one). Events with the same key are handled by the same
+// worker, so they stay in order while different keys proceed in parallel.
+func (b *Bus) SubscribeAsync(handler Handler, workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	sub := &asyncSubscriber{handler: handler, queues: make([]chan Event, workers)}
+	for i := range sub.queues {
+		queue := make(chan Event, asyncQueueSize)
+		sub.queues[i] = queue
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			for event := range queue {
+				deliver(handler, event)
+			}
+		}()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		// Nothing will ever be queued; let the workers exit
+		for _, queue := range sub.queues {
+			close(queue)
+		}
+		return
+	}
+	b.async = append(b.async, sub)
+}
+
+// Publish delivers events right away, after every previously reserved ticket
+func (b *Bus) Publish(events ...Event) {
+	b.Reserve().Publish(events...)
+}
+
+// Reserve takes the next place in the delivery order
+func (b *Bus) Reserve() *Ticket {
+	return &Ticket{bus: b, seq: b.issued.Add(1)}
+}
+
+// Publish delivers events once every earlier ticket has been published or cancelled
+func (t *Ticket) Publish(events ...Event) {
+	t.bus.release(t.seq, events)
+}
+
+// Cancel gives up the ticket's place without delivering anything. It is a no-op on a nil ticket.
+func (t *Ticket) Cancel() {
+	if t == nil {
+		return
+	}
+	t.bus.release(t.seq, nil)
+}
+
+// Start implements the server's background service interface. Asynchronous
+// workers start when they subscribe, so there is nothing left to start.
+func (b *Bus) Start() error {
+	return nil
+}
+
+// Stop stops accepting events for asynchronous subscribers and waits until
+// their queued events are handled or ctx is done. Synchronous subscribers keep
+// receiving events published afterwards.
+func (b *Bus) Stop(ctx context.Context) error {
+	b.mu.Lock()
+	if !b.closed {
+		b.closed = true
+		for _, sub := range b.async {
+			for _, queue := range sub.queues {
+				close(queue)
+			}
+		}
+	}
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release waits for the turn of ticket seq, delivers events and passes the turn on
+func (b *Bus) release(seq uint64, events []Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for b.next != seq {
+		b.turn.Wait()
+	}
+
+	for _, event := range events {
+		for _, handler := range b.sync {
+			deliver(handler, event)
+		}
+		if b.closed {
+			continue
+		}
+		for _, sub := range b.async {
+			sub.queues[Partition(event.Key(), len(sub.queues))] <- event
+		}
+	}
+
+	b.next++
+	b.turn.Broadcast()
+}
+
+// Partition maps a key onto one of n workers
+func Partition(key string, n int) int {
+	if n == 1 {
+		return 0
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(n))
+}
+
+// deliver calls handler, isolating the bus from a panicking subscriber
+func deliver(handler Handler, event Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("events: subscriber panicked handling %s: %v", event.Topic(), r)
+		}
+	}()
+	handler(event)
+}
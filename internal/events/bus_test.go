@@ -0,0 +1,90 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBusDeliversTypedEventsAndIsolatesPanics(t *testing.T) {
+	bus := NewBus()
+
+	var statuses []string
+	bus.Subscribe(func(Event) { panic("broken subscriber") })
+	bus.Subscribe(On(func(e StatusChanged) { statuses = append(statuses, e.To) }))
+
+	bus.Publish(
+		StatusChanged{IssueID: 1, From: "PENDING", To: "IN_PROGRESS"},
+		UserCreated{},
+		StatusChanged{IssueID: 1, From: "IN_PROGRESS", To: "COMPLETED"},
+	)
+
+	if len(statuses) != 2 || statuses[0] != "IN_PROGRESS" || statuses[1] != "COMPLETED" {
+		t.Errorf("Expected both status changes in order, got %v", statuses)
+	}
+}
+
+func TestTicketsDeliverInReservationOrder(t *testing.T) {
+	bus := NewBus()
+	var got []string
+	bus.Subscribe(On(func(e StatusChanged) { got = append(got, e.To) }))
+
+	first, second, third := bus.Reserve(), bus.Reserve(), bus.Reserve()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		third.Publish(StatusChanged{IssueID: 1, To: "third"})
+	}()
+	go func() {
+		defer wg.Done()
+		second.Cancel()
+	}()
+	first.Publish(StatusChanged{IssueID: 1, To: "first"})
+	wg.Wait()
+
+	if len(got) != 2 || got[0] != "first" || got[1] != "third" {
+		t.Errorf("Expected first then third, got %v", got)
+	}
+}
+
+func TestAsyncSubscribersKeepPerKeyOrderAndDrainOnStop(t *testing.T) {
+	bus := NewBus()
+
+	var mu sync.Mutex
+	seen := make(map[uint][]string)
+	bus.SubscribeAsync(On(func(e StatusChanged) {
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		seen[e.IssueID] = append(seen[e.IssueID], e.To)
+	}), 4)
+
+	for _, status := range []string{"a", "b", "c"} {
+		for id := uint(1); id <= 5; id++ {
+			bus.Publish(StatusChanged{IssueID: id, To: status})
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := bus.Stop(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for id := uint(1); id <= 5; id++ {
+		if got := seen[id]; len(got) != 3 || got[0] != "a" || got[1] != "b" || got[2] != "c" {
+			t.Errorf("Expected issue %d to see a, b, c in order, got %v", id, got)
+		}
+	}
+
+	// Events published after Stop still reach synchronous subscribers only
+	delivered := false
+	bus.Subscribe(func(Event) { delivered = true })
+	bus.Publish(UserCreated{})
+	if !delivered {
+		t.Error("Expected synchronous delivery after Stop")
+	}
+}
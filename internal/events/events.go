@@ -0,0 +1,148 @@
+// Package events carries domain events from the services to in-process subscribers.
+package events
+
+import (
+	"strconv"
+	"time"
+
+	"aoroa/internal/models"
+)
+
+// Event is a change published on the bus after it has been committed
+type Event interface {
+	// Topic names the kind of event, such as "issue.updated"
+	Topic() string
+	// Key identifies the entity the event is about. Events with the same key
+	// reach every subscriber in the order their changes were committed.
+	Key() string
+}
+
+// Event topics
+const (
+	TopicIssueCreated    = "issue.created"
+	TopicIssueUpdated    = "issue.updated"
+	TopicStatusChanged   = "issue.status_changed"
+	TopicAssigneeChanged = "issue.assignee_changed"
+	TopicIssueOverdue    = "issue.overdue"
+	TopicCommentCreated  = "comment.created"
+	TopicCommentUpdated  = "comment.updated"
+	TopicCommentDeleted  = "comment.deleted"
+	TopicUserCreated     = "user.created"
+)
+
+// Topics returns every event topic
+func Topics() []string {
+	return []string{
+		TopicIssueCreated, TopicIssueUpdated, TopicStatusChanged, TopicAssigneeChanged, TopicIssueOverdue,
+		TopicCommentCreated, TopicCommentUpdated, TopicCommentDeleted, TopicUserCreated,
+	}
+}
+
+// IsTopic reports whether topic names a known event
+func IsTopic(topic string) bool {
+	for _, known := range Topics() {
+		if topic == known {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldChange is the old and new value of a single changed issue field,
+// formatted as in the issue history. A nil value means the field was empty.
+type FieldChange struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"oldValue"`
+	NewValue *string `json:"newValue"`
+}
+
+// IssueCreated is published when an issue is created
+type IssueCreated struct {
+	Issue models.Issue `json:"issue"`
+	Actor *models.User `json:"actor,omitempty"`
+	At    time.Time    `json:"at"`
+}
+
+// IssueUpdated is published when any field of an issue changes
+type IssueUpdated struct {
+	Issue   models.Issue  `json:"issue"` // the issue after the change
+	Changes []FieldChange `json:"changes"`
+	Actor   *models.User  `json:"actor,omitempty"`
+	At      time.Time     `json:"at"`
+}
+
+// StatusChanged is published, after IssueUpdated, when an issue moves to another status
+type StatusChanged struct {
+	IssueID uint         `json:"issueId"`
+	From    string       `json:"from"`
+	To      string       `json:"to"`
+	Actor   *models.User `json:"actor,omitempty"`
+	At      time.Time    `json:"at"`
+}
+
+// AssigneeChanged is published, after IssueUpdated, when an issue is assigned,
+// reassigned or unassigned. From or To is nil for an unassigned issue.
+type AssigneeChanged struct {
+	IssueID uint         `json:"issueId"`
+	From    *models.User `json:"from"`
+	To      *models.User `json:"to"`
+	Actor   *models.User `json:"actor,omitempty"`
+	At      time.Time    `json:"at"`
+}
+
+// IssueOverdue is published once per issue and due date when an open issue
+// passes its due date. At is when the overdue check noticed it.
+type IssueOverdue struct {
+	Issue   models.Issue `json:"issue"`
+	DueDate time.Time    `json:"dueDate"`
+	At      time.Time    `json:"at"`
+}
+
+// CommentCreated is published when a comment is added to an issue
+type CommentCreated struct {
+	Comment models.Comment `json:"comment"`
+	At      time.Time      `json:"at"`
+}
+
+// CommentUpdated is published when a comment is edited by its author
+type CommentUpdated struct {
+	Comment models.Comment `json:"comment"` // the comment after the edit
+	At      time.Time      `json:"at"`
+}
+
+// CommentDeleted is published when a comment is removed by its author
+type CommentDeleted struct {
+	Comment models.Comment `json:"comment"` // the removed comment
+	At      time.Time      `json:"at"`
+}
+
+// UserCreated is published when a user is created
+type UserCreated struct {
+	User models.User `json:"user"`
+	At   time.Time   `json:"at"`
+}
+
+func (IssueCreated) Topic() string    { return TopicIssueCreated }
+func (IssueUpdated) Topic() string    { return TopicIssueUpdated }
+func (StatusChanged) Topic() string   { return TopicStatusChanged }
+func (AssigneeChanged) Topic() string { return TopicAssigneeChanged }
+func (IssueOverdue) Topic() string    { return TopicIssueOverdue }
+func (CommentCreated) Topic() string  { return TopicCommentCreated }
+func (CommentUpdated) Topic() string  { return TopicCommentUpdated }
+func (CommentDeleted) Topic() string  { return TopicCommentDeleted }
+func (UserCreated) Topic() string     { return TopicUserCreated }
+
+func (e IssueCreated) Key() string    { return IssueKey(e.Issue.ID) }
+func (e IssueUpdated) Key() string    { return IssueKey(e.Issue.ID) }
+func (e StatusChanged) Key() string   { return IssueKey(e.IssueID) }
+func (e AssigneeChanged) Key() string { return IssueKey(e.IssueID) }
+func (e IssueOverdue) Key() string    { return IssueKey(e.Issue.ID) }
+func (e CommentCreated) Key() string  { return IssueKey(e.Comment.IssueID) }
+func (e CommentUpdated) Key() string  { return IssueKey(e.Comment.IssueID) }
+func (e CommentDeleted) Key() string  { return IssueKey(e.Comment.IssueID) }
+func (e UserCreated) Key() string     { return "user:" + strconv.FormatUint(uint64(e.User.ID), 10) }
+
+// IssueKey returns the ordering key of events about an issue
+func IssueKey(id uint) string {
+	return "issue:" + strconv.FormatUint(uint64(id), 10)
+}
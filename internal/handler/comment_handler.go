@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/service"
+	"aoroa/pkg/handlers"
+	"aoroa/pkg/utils"
+)
+
+// CommentHandler implements comment operations using interface-based approach
+type CommentHandler struct {
+	commentService *service.CommentService
+}
+
+// NewCommentHandler creates a new CommentHandler
+func NewCommentHandler(commentService *service.CommentService) handlers.CommentHandlerInterface {
+	return &CommentHandler{
+		commentService: commentService,
+	}
+}
+
+// CreateComment handles adding a comment to an issue
+func (h *CommentHandler) CreateComment(ctx utils.HTTPContext) {
+	issueID, ok := parseIDParam(ctx, "id", "Invalid issue ID")
+	if !ok {
+		return
+	}
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	var req domain.CreateCommentRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	comment, err := h.commentService.CreateComment(issueID, actorID, req)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, comment)
+}
+
+// GetComments handles comment list retrieval for an issue
+func (h *CommentHandler) GetComments(ctx utils.HTTPContext) {
+	issueID, ok := parseIDParam(ctx, "id", "Invalid issue ID")
+	if !ok {
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+
+	comments, err := h.commentService.GetComments(issueID, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	response := domain.CommentsResponse{
+		Comments: make([]interface{}, len(comments)),
+	}
+	for i, comment := range comments {
+		response.Comments[i] = comment
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// UpdateComment handles editing a comment by its author
+func (h *CommentHandler) UpdateComment(ctx utils.HTTPContext) {
+	issueID, ok := parseIDParam(ctx, "id", "Invalid issue ID")
+	if !ok {
+		return
+	}
+	commentID, ok := parseIDParam(ctx, "commentId", "Invalid comment ID")
+	if !ok {
+		return
+	}
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateCommentRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	comment, err := h.commentService.UpdateComment(issueID, commentID, actorID, req)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comment)
+}
+
+// DeleteComment handles deleting a comment by its author
+func (h *CommentHandler) DeleteComment(ctx utils.HTTPContext) {
+	issueID, ok := parseIDParam(ctx, "id", "Invalid issue ID")
+	if !ok {
+		return
+	}
+	commentID, ok := parseIDParam(ctx, "commentId", "Invalid comment ID")
+	if !ok {
+		return
+	}
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.commentService.DeleteComment(issueID, commentID, actorID); err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
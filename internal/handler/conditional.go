@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"aoroa/internal/models"
+	"aoroa/pkg/utils"
+)
+
+const (
+	etagHeader        = "ETag"
+	ifMatchHeader     = "If-Match"
+	ifNoneMatchHeader = "If-None-Match"
+)
+
+// issueETag returns the strong entity tag of an issue as served: its version
+// and a hash of its JSON, e.g. "3-1f0c9a2b7d4e6a80". The hash covers derived
+// fields such as the comment count, which change without a new version.
+func issueETag(issue *models.Issue) string {
+	// Issues always encode; the hash only has to change with the body
+	body, _ := json.Marshal(issue)
+	sum := sha256.Sum256(body)
+	return `"` + strconv.FormatUint(uint64(issue.Version), 10) + "-" + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// writeIssue writes an issue along with its ETag
+func writeIssue(ctx utils.HTTPContext, status int, issue *models.Issue) {
+	ctx.SetHeader(etagHeader, issueETag(issue))
+	ctx.JSON(status, issue)
+}
+
+// writeIssueIfNoneMatch writes an issue with its ETag, or an empty 304
+// response when the If-None-Match header names the current version
+func writeIssueIfNoneMatch(ctx utils.HTTPContext, issue *models.Issue) {
+	etag := issueETag(issue)
+	if header := ctx.GetHeader(ifNoneMatchHeader); header != "" {
+		for _, tag := range splitETags(header) {
+			// Weak comparison: W/"3" matches "3"
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+				ctx.SetHeader(etagHeader, etag)
+				ctx.Status(http.StatusNotModified)
+				return
+			}
+		}
+	}
+	writeIssue(ctx, http.StatusOK, issue)
+}
+
+// parseIfMatch reads the issue versions listed in the If-Match header, from
+// ETags or plain quoted versions such as "3". Only the version is compared, so
+// changes of derived fields like a new comment do not fail an update. It
+// returns nil when the header is absent or "*", which skip the version check.
+// If-Match compares strongly, so weak and malformed tags never match.
+func parseIfMatch(ctx utils.HTTPContext) []uint {
+	header := strings.TrimSpace(ctx.GetHeader(ifMatchHeader))
+	if header == "" || header == "*" {
+		return nil
+	}
+
+	versions := []uint{}
+	for _, tag := range splitETags(header) {
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+		number, _, _ := strings.Cut(tag[1:len(tag)-1], "-")
+		version, err := strconv.ParseUint(number, 10, 32)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, uint(version))
+	}
+	return versions
+}
+
+// splitETags splits a comma-separated list of entity tags
+func splitETags(header string) []string {
+	var tags []string
+	for _, tag := range strings.Split(header, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
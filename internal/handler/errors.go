@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"aoroa/internal/domain"
+	"aoroa/pkg/utils"
+)
+
+// Codes for errors raised by the handler layer itself
+const (
+	codeInvalidRequest = "invalid_request"
+	codeInvalidID      = "invalid_id"
+	codeInvalidActor   = "invalid_actor"
+	codeInvalidQuery   = "invalid_query"
+	codeInternal       = "internal_error"
+)
+
+// Problem details (RFC 7807) settings
+const (
+	problemContentType = "application/problem+json"
+	// problemTypePrefix namespaces problem type URIs by domain error code
+	problemTypePrefix = "urn:aoroa:problem:"
+)
+
+// statusForKind maps domain error kinds to HTTP status codes
+var statusForKind = map[domain.ErrorKind]int{
+	domain.KindNotFound:           http.StatusNotFound,
+	domain.KindConflict:           http.StatusConflict,
+	domain.KindValidation:         http.StatusBadRequest,
+	domain.KindForbidden:          http.StatusForbidden,
+	domain.KindPreconditionFailed: http.StatusPreconditionFailed,
+}
+
+// titleForKind holds the problem title for each domain error kind
+var titleForKind = map[domain.ErrorKind]string{
+	domain.KindNotFound:           "Resource not found",
+	domain.KindConflict:           "Business rule violated",
+	domain.KindValidation:         "Invalid request",
+	domain.KindForbidden:          "Action not permitted",
+	domain.KindPreconditionFailed: "Precondition failed",
+}
+
+// writeError writes err as an error response. Domain errors are mapped by kind;
+// any other error is an unexpected failure and reported as 500 without its message.
+// Clients that accept application/problem+json get RFC 7807 problem details,
+// everyone else the legacy ErrorResponse shape.
+func writeError(ctx utils.HTTPContext, err error) {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		domainErr = &domain.Error{Code: codeInternal, Message: "internal server error"}
+	}
+
+	statusCode, ok := statusForKind[domainErr.Kind]
+	if !ok {
+		statusCode = http.StatusInternalServerError
+	}
+
+	if acceptsProblemJSON(ctx.GetHeader("Accept")) {
+		ctx.SetHeader("Content-Type", problemContentType)
+		ctx.JSON(statusCode, newProblemDetails(ctx, statusCode, domainErr))
+		return
+	}
+
+	ctx.JSON(statusCode, domain.ErrorResponse{
+		Error:     domainErr.Message,
+		Code:      statusCode,
+		ErrorCode: domainErr.Code,
+		Details:   domainErr.Details,
+	})
+}
+
+// newProblemDetails converts a domain error into RFC 7807 problem details for the current request
+func newProblemDetails(ctx utils.HTTPContext, statusCode int, err *domain.Error) domain.ProblemDetails {
+	title, ok := titleForKind[err.Kind]
+	if !ok {
+		title = http.StatusText(statusCode)
+	}
+
+	problem := domain.ProblemDetails{
+		Type:    problemTypePrefix + err.Code,
+		Title:   title,
+		Status:  statusCode,
+		Detail:  err.Message,
+		Code:    err.Code,
+		Errors:  err.Fields,
+		Details: err.Details,
+	}
+	if u := ctx.GetURL(); u != nil {
+		problem.Instance = u.Path
+	}
+	return problem
+}
+
+// acceptsProblemJSON reports whether the Accept header explicitly asks for
+// application/problem+json. Wildcards keep the legacy format.
+func acceptsProblemJSON(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || mediaType != problemContentType {
+			continue
+		}
+		if q, err := strconv.ParseFloat(params["q"], 64); err == nil && q <= 0 {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// invalidRequest reports a request body that could not be decoded
+func invalidRequest(err error) error {
+	return domain.Validation(codeInvalidRequest, "Invalid request: "+err.Error())
+}
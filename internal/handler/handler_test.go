@@ -1,10 +1,13 @@
 package handler
 
 import (
+"bufio"
 "bytes"
 "encoding/json"
 "net/http"
 "net/http/httptest"
+"strconv"
+"strings"
 "testing"
 
 "aoroa/internal/domain"
@@ -62,3 +65,495 @@ func TestCreateIssueWithStandardHTTP(t *testing.T) {
 		t.Errorf("Expected title %s, got %s", request.Title, response["title"])
 	}
 }
+
+// TestCreateUserRejectsDuplicateEmail tests that CreateUser maps uniqueness violations to 409
+func TestCreateUserRejectsDuplicateEmail(t *testing.T) {
+	userService := service.NewUserService()
+	handler := NewUserHandler(userService)
+
+	body := []byte(`{"name": "Kim Clone", "email": "kim@example.com"}`)
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+
+	handler.CreateUser(utils.NewStandardHTTPAdapter(rr, req))
+
+	if status := rr.Code; status != http.StatusConflict {
+		t.Errorf("Expected status code %d, got %d", http.StatusConflict, status)
+	}
+}
+
+// TestDeactivateUserWithStandardHTTP tests DeactivateUser using standard HTTP
+func TestDeactivateUserWithStandardHTTP(t *testing.T) {
+	userService := service.NewUserService()
+	handler := NewUserHandler(userService)
+
+	req := httptest.NewRequest(http.MethodPost, "/users/2/deactivate", nil)
+	req.Header.Set("X-User-ID", "1")
+	rr := httptest.NewRecorder()
+
+	ctx := utils.NewStandardHTTPAdapterWithParams(rr, req, map[string]string{"id": "2"})
+	handler.DeactivateUser(ctx)
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, status)
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if response["deactivatedAt"] == nil {
+		t.Error("Expected deactivatedAt to be set")
+	}
+}
+
+// TestCreateCommentRequiresActor tests that CreateComment rejects requests without X-User-ID
+func TestCreateCommentRequiresActor(t *testing.T) {
+	userService := service.NewUserService()
+	issueService := service.NewIssueService(userService)
+	handler := NewCommentHandler(service.NewCommentService(issueService))
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Test Issue"})
+
+	body := []byte(`{"body": "hello"}`)
+	req := httptest.NewRequest(http.MethodPost, "/issue/1/comments", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+	params := map[string]string{"id": strconv.Itoa(int(issue.ID))}
+	handler.CreateComment(utils.NewStandardHTTPAdapterWithParams(rr, req, params))
+
+	if status := rr.Code; status != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, status)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/issue/1/comments", bytes.NewBuffer(body))
+	req.Header.Set("X-User-ID", "2")
+	rr = httptest.NewRecorder()
+	handler.CreateComment(utils.NewStandardHTTPAdapterWithParams(rr, req, params))
+
+	if status := rr.Code; status != http.StatusCreated {
+		t.Errorf("Expected status code %d, got %d", http.StatusCreated, status)
+	}
+}
+
+// TestGetIssuesReturnsNextLink tests that GetIssues links to the next page
+func TestGetIssuesReturnsNextLink(t *testing.T) {
+	userService := service.NewUserService()
+	issueService := service.NewIssueService(userService)
+	handler := NewIssueHandler(issueService)
+
+	for _, title := range []string{"first", "second"} {
+		issueService.CreateIssue(domain.CreateIssueRequest{Title: title})
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/issues?limit=1&sort=title", nil)
+	rr := httptest.NewRecorder()
+	handler.GetIssues(utils.NewStandardHTTPAdapter(rr, req))
+
+	if status := rr.Code; status != http.StatusOK {
+		t.Fatalf("Expected status code %d, got %d", http.StatusOK, status)
+	}
+
+	var response domain.IssuesResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if response.Total != 2 || len(response.Issues) != 1 {
+		t.Errorf("Expected 1 of 2 issues, got %d of %d", len(response.Issues), response.Total)
+	}
+	if response.NextCursor == "" || !strings.Contains(response.Next, "cursor="+response.NextCursor) || !strings.Contains(response.Next, "sort=title") {
+		t.Errorf("Unexpected next link %q", response.Next)
+	}
+}
+
+// TestGetIssuesParsesFilters tests multi-value and unassigned filters from the query string
+func TestGetIssuesParsesFilters(t *testing.T) {
+	userService := service.NewUserService()
+	issueService := service.NewIssueService(userService)
+	handler := NewIssueHandler(issueService)
+
+	assignee := uint(1)
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "unassigned"})
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "assigned to 1", UserID: &assignee})
+
+	tests := []struct {
+		query    string
+		status   int
+		expected int
+	}{
+		{"/issues?assignee=unassigned", http.StatusOK, 1},
+		{"/issues?assignee=1,unassigned", http.StatusOK, 2},
+		{"/issues?status=PENDING&status=IN_PROGRESS&q=assigned", http.StatusOK, 2},
+		{"/issues?createdAfter=2000-01-01", http.StatusOK, 2},
+		{"/issues?createdAfter=yesterday", http.StatusBadRequest, 0},
+		{"/issues?assignee=someone", http.StatusBadRequest, 0},
+		{"/issues?label=bug,ui&labelMatch=any", http.StatusOK, 0},
+		{"/issues?label=bug&labelMatch=some", http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.query, nil)
+		rr := httptest.NewRecorder()
+		handler.GetIssues(utils.NewStandardHTTPAdapter(rr, req))
+
+		if rr.Code != tt.status {
+			t.Errorf("%s: expected status %d, got %d", tt.query, tt.status, rr.Code)
+			continue
+		}
+		if tt.status != http.StatusOK {
+			continue
+		}
+
+		var response domain.IssuesResponse
+		json.Unmarshal(rr.Body.Bytes(), &response)
+		if len(response.Issues) != tt.expected {
+			t.Errorf("%s: expected %d issues, got %d", tt.query, tt.expected, len(response.Issues))
+		}
+	}
+}
+
+// TestUpdateIssueMapsGuardFailureToConflict tests that workflow guard errors are reported as 409 with their code
+func TestUpdateIssueMapsGuardFailureToConflict(t *testing.T) {
+	userService := service.NewUserService()
+	issueService := service.NewIssueService(userService)
+	handler := NewIssueHandler(issueService)
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Test Issue"})
+
+	body := []byte(`{"status": "IN_PROGRESS"}`)
+	req := httptest.NewRequest(http.MethodPut, "/issue/1", bytes.NewBuffer(body))
+	rr := httptest.NewRecorder()
+	params := map[string]string{"id": strconv.Itoa(int(issue.ID))}
+	handler.UpdateIssue(utils.NewStandardHTTPAdapterWithParams(rr, req, params))
+
+	if status := rr.Code; status != http.StatusConflict {
+		t.Fatalf("Expected status code %d, got %d", http.StatusConflict, status)
+	}
+
+	var response domain.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if response.ErrorCode != domain.ErrAssigneeRequired.Code {
+		t.Errorf("Expected error code %s, got %s", domain.ErrAssigneeRequired.Code, response.ErrorCode)
+	}
+	if response.Details["status"] != domain.StatusInProgress {
+		t.Errorf("Expected details to name status %s, got %v", domain.StatusInProgress, response.Details)
+	}
+}
+
+// TestUpdateIssueRejectsWronglyTypedFields tests that update fields of the wrong JSON type are 400s and leave the issue as it was
+func TestUpdateIssueRejectsWronglyTypedFields(t *testing.T) {
+	userService := service.NewUserService()
+	issueService := service.NewIssueService(userService)
+	labelService := service.NewLabelService(userService)
+	handler := NewIssueHandler(issueService)
+
+	adminID := uint(1)
+	labelService.CreateLabel(domain.CreateLabelRequest{Name: "bug"}, &adminID)
+	parent, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Parent"})
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Test Issue", Severity: "major", ParentID: &parent.ID, Labels: []string{"bug"}})
+
+	tests := []struct {
+		body  string
+		field string
+	}{
+		{`{"labels": "bug"}`, "labels"},
+		{`{"labels": ["bug", 1]}`, "labels"},
+		{`{"severity": 1}`, "severity"},
+		{`{"priority": 1}`, "priority"},
+		{`{"priority": null}`, "priority"},
+		{`{"parentId": "1"}`, "parentId"},
+		{`{"parentId": 1.5}`, "parentId"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPatch, "/issue/2", strings.NewReader(tt.body))
+		rr := httptest.NewRecorder()
+		params := map[string]string{"id": strconv.Itoa(int(issue.ID))}
+		handler.UpdateIssue(utils.NewStandardHTTPAdapterWithParams(rr, req, params))
+
+		if rr.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status code %d, got %d", tt.body, http.StatusBadRequest, rr.Code)
+			continue
+		}
+		var response domain.ErrorResponse
+		if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if response.Details["field"] != tt.field {
+			t.Errorf("%s: expected details to name %s, got %v", tt.body, tt.field, response.Details)
+		}
+	}
+
+	current, _ := issueService.GetIssue(issue.ID, nil)
+	if len(current.LabelIDs) != 1 || current.Severity != "major" || current.ParentID == nil || current.Version != issue.Version {
+		t.Errorf("Expected the issue to be unchanged, got %+v", current)
+	}
+}
+
+// TestIssueConditionalRequests tests the issue ETag with If-None-Match on reads and If-Match on updates
+func TestIssueConditionalRequests(t *testing.T) {
+	userService := service.NewUserService()
+	issueService := service.NewIssueService(userService)
+	handler := NewIssueHandler(issueService)
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Test Issue"})
+	params := map[string]string{"id": strconv.Itoa(int(issue.ID))}
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/issue/1", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rr := httptest.NewRecorder()
+		handler.GetIssue(utils.NewStandardHTTPAdapterWithParams(rr, req, params))
+		return rr
+	}
+	update := func(ifMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPatch, "/issue/1", bytes.NewBufferString(`{"title": "Edited"}`))
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		rr := httptest.NewRecorder()
+		handler.UpdateIssue(utils.NewStandardHTTPAdapterWithParams(rr, req, params))
+		return rr
+	}
+
+	rr := get("")
+	etag := rr.Header().Get("ETag")
+	if rr.Code != http.StatusOK || !strings.HasPrefix(etag, `"1-`) {
+		t.Fatalf("Expected 200 with an ETag of version 1, got %d with %q", rr.Code, etag)
+	}
+	for _, ifNoneMatch := range []string{etag, "W/" + etag, `"7-0", ` + etag, "*"} {
+		if rr := get(ifNoneMatch); rr.Code != http.StatusNotModified || rr.Body.Len() != 0 || rr.Header().Get("ETag") != etag {
+			t.Errorf("Expected an empty 304 for If-None-Match %s, got %d", ifNoneMatch, rr.Code)
+		}
+	}
+
+	// A new comment changes the body but not the version
+	commentService := service.NewCommentService(issueService)
+	if _, err := commentService.CreateComment(issue.ID, 1, domain.CreateCommentRequest{Body: "note"}); err != nil {
+		t.Fatalf("Failed to comment: %v", err)
+	}
+	rr = get(etag)
+	if rr.Code != http.StatusOK || rr.Header().Get("ETag") == etag || !strings.HasPrefix(rr.Header().Get("ETag"), `"1-`) {
+		t.Fatalf("Expected 200 with a new ETag of version 1, got %d with %q", rr.Code, rr.Header().Get("ETag"))
+	}
+
+	// ...so it does not fail an update made against the earlier ETag
+	rr = update(etag)
+	if rr.Code != http.StatusOK || !strings.HasPrefix(rr.Header().Get("ETag"), `"2-`) {
+		t.Fatalf("Expected 200 with an ETag of version 2, got %d with %q", rr.Code, rr.Header().Get("ETag"))
+	}
+	for _, ifMatch := range []string{etag, `"1"`, `W/"2"`, "2", "garbage"} {
+		rr := update(ifMatch)
+		if rr.Code != http.StatusPreconditionFailed {
+			t.Errorf("Expected 412 for If-Match %s, got %d", ifMatch, rr.Code)
+			continue
+		}
+		var response domain.ErrorResponse
+		if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if response.ErrorCode != domain.ErrIssueModified.Code {
+			t.Errorf("Expected error code %s, got %s", domain.ErrIssueModified.Code, response.ErrorCode)
+		}
+	}
+
+	// A plain quoted version, a wildcard or no header
+	if rr := update(`"2"`); rr.Code != http.StatusOK || !strings.HasPrefix(rr.Header().Get("ETag"), `"3-`) {
+		t.Errorf("Expected 200 with an ETag of version 3, got %d with %q", rr.Code, rr.Header().Get("ETag"))
+	}
+	if rr := update("*"); rr.Code != http.StatusOK {
+		t.Errorf("Expected 200 for If-Match *, got %d", rr.Code)
+	}
+	if rr := update(""); rr.Code != http.StatusOK {
+		t.Errorf("Expected 200 without If-Match, got %d", rr.Code)
+	}
+}
+
+// TestIssueChildrenFollowParentUpdates tests that parentId set and cleared through an update is reflected in the children listing
+func TestIssueChildrenFollowParentUpdates(t *testing.T) {
+	userService := service.NewUserService()
+	issueService := service.NewIssueService(userService)
+	handler := NewIssueHandler(issueService)
+
+	parent, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Parent"})
+	child, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Child"})
+
+	for _, tt := range []struct {
+		body     string
+		children int
+	}{
+		{`{"parentId": 1}`, 1},
+		{`{"parentId": null}`, 0},
+	} {
+		req := httptest.NewRequest(http.MethodPatch, "/issue/2", bytes.NewBufferString(tt.body))
+		rr := httptest.NewRecorder()
+		handler.UpdateIssue(utils.NewStandardHTTPAdapterWithParams(rr, req, map[string]string{"id": strconv.Itoa(int(child.ID))}))
+		if rr.Code != http.StatusOK {
+			t.Fatalf("Expected status code %d for %s, got %d", http.StatusOK, tt.body, rr.Code)
+		}
+
+		req = httptest.NewRequest(http.MethodGet, "/issue/1/children", nil)
+		rr = httptest.NewRecorder()
+		handler.GetIssueChildren(utils.NewStandardHTTPAdapterWithParams(rr, req, map[string]string{"id": strconv.Itoa(int(parent.ID))}))
+
+		var response domain.IssuesResponse
+		if err := json.Unmarshal(rr.Body.Bytes(), &response); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if response.Total != tt.children {
+			t.Errorf("Expected %d children after %s, got %d", tt.children, tt.body, response.Total)
+		}
+	}
+}
+
+// TestErrorNegotiatesProblemJSON tests that errors use RFC 7807 problem details when the client accepts them
+func TestErrorNegotiatesProblemJSON(t *testing.T) {
+	handler := NewUserHandler(service.NewUserService())
+
+	body := []byte(`{"name": "", "email": "not-an-email"}`)
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBuffer(body))
+	req.Header.Set("Accept", "application/json, application/problem+json")
+	rr := httptest.NewRecorder()
+	handler.CreateUser(utils.NewStandardHTTPAdapter(rr, req))
+
+	if status := rr.Code; status != http.StatusBadRequest {
+		t.Fatalf("Expected status code %d, got %d", http.StatusBadRequest, status)
+	}
+	if contentType := rr.Header().Get("Content-Type"); contentType != "application/problem+json" {
+		t.Errorf("Expected problem+json content type, got %s", contentType)
+	}
+
+	var problem domain.ProblemDetails
+	if err := json.Unmarshal(rr.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if problem.Status != http.StatusBadRequest || problem.Instance != "/users" || problem.Type == "" {
+		t.Errorf("Unexpected problem details: %+v", problem)
+	}
+	if len(problem.Errors) != 2 || problem.Errors[0].Field != "name" || problem.Errors[1].Field != "email" {
+		t.Errorf("Expected name and email field errors, got %+v", problem.Errors)
+	}
+
+	// Without an explicit Accept the legacy shape is kept
+	req = httptest.NewRequest(http.MethodPost, "/users", bytes.NewBuffer(body))
+	rr = httptest.NewRecorder()
+	handler.CreateUser(utils.NewStandardHTTPAdapter(rr, req))
+
+	var legacy domain.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &legacy); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if legacy.Code != http.StatusBadRequest || legacy.ErrorCode != domain.ErrValidationFailed.Code {
+		t.Errorf("Unexpected legacy error response: %+v", legacy)
+	}
+}
+
+// TestProjectIssueRoutesRequireMembership tests that project issue routes are limited to members and admins
+func TestProjectIssueRoutesRequireMembership(t *testing.T) {
+	userService := service.NewUserService()
+	issueService := service.NewIssueService(userService)
+	projectService := service.NewProjectService(userService)
+	handler := NewProjectHandler(projectService, issueService)
+
+	if _, err := projectService.CreateProject(domain.CreateProjectRequest{Key: "WEB", Name: "Web", MemberIDs: []uint{2}}, 1); err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	params := map[string]string{"key": "web"}
+
+	tests := []struct {
+		actor    string
+		expected int
+	}{
+		{"", http.StatusBadRequest},
+		{"3", http.StatusForbidden},
+		{"2", http.StatusCreated},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/projects/web/issues", bytes.NewBufferString(`{"title": "Test Issue"}`))
+		if tt.actor != "" {
+			req.Header.Set("X-User-ID", tt.actor)
+		}
+		rr := httptest.NewRecorder()
+		handler.CreateProjectIssue(utils.NewStandardHTTPAdapterWithParams(rr, req, params))
+
+		if status := rr.Code; status != tt.expected {
+			t.Errorf("Actor %q: expected status code %d, got %d", tt.actor, tt.expected, status)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects/web/issues/1", nil)
+	req.Header.Set("X-User-ID", "2")
+	rr := httptest.NewRecorder()
+	handler.GetProjectIssue(utils.NewStandardHTTPAdapterWithParams(rr, req, map[string]string{"key": "web", "number": "1"}))
+
+	var issue map[string]interface{}
+	if err := json.Unmarshal(rr.Body.Bytes(), &issue); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if issue["key"] != "WEB-1" {
+		t.Errorf("Expected issue key WEB-1, got %v", issue["key"])
+	}
+}
+
+// TestStreamIssuesSendsServerSentEvents tests that the issue stream resumes from Last-Event-ID and pushes live changes
+func TestStreamIssuesSendsServerSentEvents(t *testing.T) {
+	userService := service.NewUserService()
+	issueService := service.NewIssueService(userService)
+	handler := NewIssueStreamHandler(service.NewIssueStream(issueService, service.DefaultStreamReplaySize))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.StreamIssues(utils.NewStandardHTTPAdapter(w, r))
+	}))
+	defer server.Close()
+
+	first, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "First"})
+	second, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Second"})
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"?status=PENDING", nil)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to open stream: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Expected text/event-stream, got %q", ct)
+	}
+
+	// Live change after the replayed creation of the second issue
+	status, assignee := domain.StatusInProgress, uint(1)
+	if _, err := issueService.UpdateIssue(first.ID, domain.UpdateIssueRequest{Status: &status, UserID: &assignee}); err != nil {
+		t.Fatalf("Failed to update issue: %v", err)
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	readEvent := func() map[string]string {
+		fields := make(map[string]string)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				t.Fatalf("Failed to read stream: %v", err)
+			}
+			line = strings.TrimSuffix(line, "\n")
+			if line == "" {
+				return fields
+			}
+			if name, value, ok := strings.Cut(line, ":"); ok {
+				fields[name] = value
+			}
+		}
+	}
+
+	replayed := readEvent()
+	if replayed["id"] != "2" || replayed["event"] != "issue.created" || !strings.Contains(replayed["data"], `"title":"`+second.Title+`"`) {
+		t.Errorf("Unexpected replayed event: %v", replayed)
+	}
+	live := readEvent()
+	if live["id"] != "3" || live["event"] != "issue.updated" || !strings.Contains(live["data"], `"status":"IN_PROGRESS"`) {
+		t.Errorf("Unexpected live event: %v", live)
+	}
+}
@@ -1,7 +1,10 @@
 package handler
 
 import (
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"aoroa/internal/domain"
 	"aoroa/internal/service"
@@ -25,27 +28,26 @@ func NewIssueHandler(issueService *service.IssueService) handlers.IssueHandlerIn
 func (h *IssueHandler) CreateIssue(ctx utils.HTTPContext) {
 	var req domain.CreateIssueRequest
 	if err := ctx.BindJSON(&req); err != nil {
-		ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
-			Error: "Invalid request: " + err.Error(),
-			Code:  http.StatusBadRequest,
-		})
+		writeError(ctx, invalidRequest(err))
 		return
 	}
 
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+	req.ActorID = actorID
+
 	issue, err := h.issueService.CreateIssue(req)
 	if err != nil {
-		statusCode := utils.GetHTTPStatusForError(err.Error())
-		ctx.JSON(statusCode, domain.ErrorResponse{
-			Error: err.Error(),
-			Code:  statusCode,
-		})
+		writeError(ctx, err)
 		return
 	}
 
-	ctx.JSON(http.StatusCreated, issue)
+	writeIssue(ctx, http.StatusCreated, issue)
 }
 
-// GetIssue handles single issue retrieval
+// GetIssue handles single issue retrieval, answering 304 when If-None-Match names the current version
 func (h *IssueHandler) GetIssue(ctx utils.HTTPContext) {
 	idParam := ctx.GetParam("id")
 	if idParam == "" {
@@ -58,88 +60,253 @@ func (h *IssueHandler) GetIssue(ctx utils.HTTPContext) {
 
 	id, err := utils.ParseUintParam(idParam)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
-			Error: "Invalid issue ID",
-			Code:  http.StatusBadRequest,
-		})
+		writeError(ctx, domain.Validation(codeInvalidID, "Invalid issue ID"))
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
 		return
 	}
 
-	issue, err := h.issueService.GetIssue(id)
+	issue, err := h.issueService.GetIssue(id, actorID)
 	if err != nil {
-		ctx.JSON(http.StatusNotFound, domain.ErrorResponse{
-			Error: err.Error(),
-			Code:  http.StatusNotFound,
-		})
+		writeError(ctx, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, issue)
+	writeIssueIfNoneMatch(ctx, issue)
 }
 
-// GetIssues handles issue list retrieval
+// GetIssues handles issue list retrieval with filtering, sorting and cursor pagination
 func (h *IssueHandler) GetIssues(ctx utils.HTTPContext) {
-	status := ctx.GetQuery("status")
+	query, err := parseIssueListQuery(ctx)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+	query.ActorID = actorID
 
-	issues, err := h.issueService.GetIssues(status)
+	writeIssuePage(ctx, h.issueService, query)
+}
+
+// writeIssuePage lists a page of issues and writes it with a link to the next page
+func writeIssuePage(ctx utils.HTTPContext, issueService *service.IssueService, query domain.IssueListQuery) {
+	page, err := issueService.ListIssues(query)
 	if err != nil {
-		statusCode := utils.GetHTTPStatusForError(err.Error())
-		ctx.JSON(statusCode, domain.ErrorResponse{
-			Error: err.Error(),
-			Code:  statusCode,
-		})
+		writeError(ctx, err)
 		return
 	}
 
 	response := domain.IssuesResponse{
-		Issues: make([]interface{}, len(issues)),
+		Issues:     make([]interface{}, len(page.Issues)),
+		Total:      page.Total,
+		NextCursor: page.NextCursor,
 	}
-	for i, issue := range issues {
+	for i, issue := range page.Issues {
 		response.Issues[i] = issue
 	}
+	if page.NextCursor != "" {
+		response.Next = nextPageLink(ctx, page.NextCursor)
+	}
 
 	ctx.JSON(http.StatusOK, response)
 }
 
-// UpdateIssue handles issue updates
+// nextPageLink returns the current request URI with the cursor replaced
+func nextPageLink(ctx utils.HTTPContext, cursor string) string {
+	u := ctx.GetURL()
+	query := u.Query()
+	query.Set("cursor", cursor)
+	u.RawQuery = query.Encode()
+	return u.RequestURI()
+}
+
+// UpdateIssue handles issue updates. An If-Match header makes the update fail
+// with 412 unless the issue is still at the version it names.
 func (h *IssueHandler) UpdateIssue(ctx utils.HTTPContext) {
 	idParam := ctx.GetParam("id")
 	id, err := utils.ParseUintParam(idParam)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
-			Error: "Invalid issue ID",
-			Code:  http.StatusBadRequest,
-		})
+		writeError(ctx, domain.Validation(codeInvalidID, "Invalid issue ID"))
 		return
 	}
 
 	// Parse request body manually to handle null userId
 	var rawBody map[string]interface{}
 	if err := ctx.BindJSON(&rawBody); err != nil {
-		ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
-			Error: "Invalid request: " + err.Error(),
-			Code:  http.StatusBadRequest,
-		})
+		writeError(ctx, invalidRequest(err))
 		return
 	}
 
-	req := parseUpdateRequest(rawBody)
+	req, err := parseUpdateRequest(rawBody)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+	req.ActorID = actorID
+	req.IfMatch = parseIfMatch(ctx)
 
 	issue, err := h.issueService.UpdateIssue(id, req)
 	if err != nil {
-		statusCode := utils.GetHTTPStatusForError(err.Error())
-		ctx.JSON(statusCode, domain.ErrorResponse{
-			Error: err.Error(),
-			Code:  statusCode,
-		})
+		writeError(ctx, err)
+		return
+	}
+
+	writeIssue(ctx, http.StatusOK, issue)
+}
+
+// GetIssueHistory handles retrieval of an issue's change history
+func (h *IssueHandler) GetIssueHistory(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid issue ID")
+	if !ok {
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+
+	history, err := h.issueService.GetIssueHistory(id, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	response := domain.HistoryResponse{
+		History: make([]interface{}, len(history)),
+	}
+	for i, entry := range history {
+		response.History[i] = entry
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetIssueChildren handles retrieval of an issue's sub-tasks
+func (h *IssueHandler) GetIssueChildren(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid issue ID")
+	if !ok {
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+
+	children, err := h.issueService.GetIssueChildren(id, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	response := domain.IssuesResponse{
+		Issues: make([]interface{}, len(children)),
+		Total:  len(children),
+	}
+	for i, child := range children {
+		response.Issues[i] = child
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// SearchIssues handles full-text issue search
+func (h *IssueHandler) SearchIssues(ctx utils.HTTPContext) {
+	query := ctx.GetQuery("q")
+
+	limit := 0
+	if limitParam := ctx.GetQuery("limit"); limitParam != "" {
+		parsed, err := strconv.Atoi(limitParam)
+		if err != nil || parsed < 1 {
+			writeError(ctx, domain.ErrInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+
+	results, err := h.issueService.SearchIssues(query, limit, actorID)
+	if err != nil {
+		writeError(ctx, err)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, issue)
+	response := domain.SearchResponse{
+		Query:   query,
+		Results: make([]interface{}, len(results)),
+	}
+	for i, result := range results {
+		response.Results[i] = result
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetChanges handles reading the changes feed after a sequence number
+func (h *IssueHandler) GetChanges(ctx utils.HTTPContext) {
+	var query domain.ChangesQuery
+
+	if sinceParam := ctx.GetQuery("since"); sinceParam != "" {
+		since, err := utils.ParseUintParam(sinceParam)
+		if err != nil {
+			writeError(ctx, domain.ErrInvalidSince)
+			return
+		}
+		query.Since = since
+	}
+
+	if limitParam := ctx.GetQuery("limit"); limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 1 {
+			writeError(ctx, domain.ErrInvalidLimit)
+			return
+		}
+		query.Limit = limit
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+	query.ActorID = actorID
+
+	page, err := h.issueService.GetChanges(query)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	response := domain.ChangesResponse{
+		Changes:   make([]interface{}, len(page.Changes)),
+		NextSince: page.NextSince,
+		HasMore:   page.HasMore,
+	}
+	for i, change := range page.Changes {
+		response.Changes[i] = change
+	}
+
+	ctx.JSON(http.StatusOK, response)
 }
 
 // parseUpdateRequest parses the raw request body into UpdateIssueRequest
-func parseUpdateRequest(rawBody map[string]interface{}) domain.UpdateIssueRequest {
+func parseUpdateRequest(rawBody map[string]interface{}) (domain.UpdateIssueRequest, error) {
 	req := domain.UpdateIssueRequest{}
 
 	// Handle title
@@ -163,6 +330,81 @@ func parseUpdateRequest(rawBody map[string]interface{}) domain.UpdateIssueReques
 		}
 	}
 
+	// Handle priority
+	if priority, exists := rawBody["priority"]; exists {
+		priorityStr, ok := priority.(string)
+		if !ok {
+			return req, invalidField("priority", "string")
+		}
+		req.Priority = &priorityStr
+	}
+
+	// Handle severity (null clears it)
+	if severity, exists := rawBody["severity"]; exists {
+		severityStr, ok := severity.(string)
+		if !ok && severity != nil {
+			return req, invalidField("severity", "string or null")
+		}
+		req.Severity = &severityStr
+	}
+
+	// Handle start and due dates (null clears them)
+	dates := []struct {
+		field  string
+		target **time.Time
+		remove *bool
+	}{
+		{"startDate", &req.StartDate, &req.RemoveStartDate},
+		{"dueDate", &req.DueDate, &req.RemoveDueDate},
+	}
+	for _, date := range dates {
+		value, exists := rawBody[date.field]
+		if !exists {
+			continue
+		}
+		if value == nil {
+			*date.remove = true
+			continue
+		}
+		text, _ := value.(string)
+		t, err := time.Parse(time.RFC3339, text)
+		if err != nil {
+			return req, invalidField(date.field, "RFC 3339 time")
+		}
+		*date.target = &t
+	}
+
+	// Handle labels (null removes every label)
+	if labels, exists := rawBody["labels"]; exists {
+		list, ok := labels.([]interface{})
+		if !ok && labels != nil {
+			return req, invalidField("labels", "list of label names or null")
+		}
+		names := []string{}
+		for _, item := range list {
+			name, ok := item.(string)
+			if !ok {
+				return req, invalidField("labels", "list of label names or null")
+			}
+			names = append(names, name)
+		}
+		req.Labels = &names
+	}
+
+	// Handle parentId (null detaches the issue from its parent)
+	if parentID, exists := rawBody["parentId"]; exists {
+		if parentID == nil {
+			req.RemoveParent = true
+		} else {
+			parentIDFloat, ok := parentID.(float64)
+			if !ok || parentIDFloat < 1 || parentIDFloat != math.Trunc(parentIDFloat) {
+				return req, invalidField("parentId", "issue ID or null")
+			}
+			parentIDUint := uint(parentIDFloat)
+			req.ParentID = &parentIDUint
+		}
+	}
+
 	// Handle userId (including null)
 	if userID, exists := rawBody["userId"]; exists {
 		if userID == nil {
@@ -173,5 +415,10 @@ func parseUpdateRequest(rawBody map[string]interface{}) domain.UpdateIssueReques
 		}
 	}
 
-	return req
+	return req, nil
+}
+
+// invalidField reports an update field whose JSON value has the wrong type
+func invalidField(field, expected string) *domain.Error {
+	return domain.Validation(codeInvalidRequest, "Invalid "+field+": expected "+expected).WithDetail("field", field)
 }
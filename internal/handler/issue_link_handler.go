@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+
+	"aoroa/internal/domain"
+	"aoroa/pkg/utils"
+)
+
+// CreateIssueLink handles linking an issue to another issue
+func (h *IssueHandler) CreateIssueLink(ctx utils.HTTPContext) {
+	issueID, ok := parseIDParam(ctx, "id", "Invalid issue ID")
+	if !ok {
+		return
+	}
+
+	var req domain.CreateIssueLinkRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+	req.ActorID = actorID
+
+	link, err := h.issueService.CreateIssueLink(issueID, req)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, link)
+}
+
+// GetIssueLinks handles retrieval of an issue's links
+func (h *IssueHandler) GetIssueLinks(ctx utils.HTTPContext) {
+	issueID, ok := parseIDParam(ctx, "id", "Invalid issue ID")
+	if !ok {
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+
+	links, err := h.issueService.GetIssueLinks(issueID, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	response := domain.IssueLinksResponse{
+		Links: make([]interface{}, len(links)),
+	}
+	for i, link := range links {
+		response.Links[i] = link
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// DeleteIssueLink handles removing a link from an issue
+func (h *IssueHandler) DeleteIssueLink(ctx utils.HTTPContext) {
+	issueID, ok := parseIDParam(ctx, "id", "Invalid issue ID")
+	if !ok {
+		return
+	}
+	linkID, ok := parseIDParam(ctx, "linkId", "Invalid link ID")
+	if !ok {
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.issueService.DeleteIssueLink(issueID, linkID, actorID); err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
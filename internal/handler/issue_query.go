@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/pkg/utils"
+)
+
+// unassignedFilterValue selects issues without assignee in the assignee filter
+const unassignedFilterValue = "unassigned"
+
+// parseIssueListQuery builds a listing query from the request's query string.
+// Multi-valued filters accept repeated parameters and comma-separated lists.
+func parseIssueListQuery(ctx utils.HTTPContext) (domain.IssueListQuery, error) {
+	values := ctx.GetURL().Query()
+
+	query := domain.IssueListQuery{
+		Sort:   values.Get("sort"),
+		Order:  values.Get("order"),
+		Cursor: values.Get("cursor"),
+	}
+
+	if limitParam := values.Get("limit"); limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 1 {
+			return query, domain.ErrInvalidLimit
+		}
+		query.Limit = limit
+	}
+
+	filter, err := parseIssueFilter(values)
+	if err != nil {
+		return query, err
+	}
+	query.Filter = filter
+
+	return query, nil
+}
+
+// parseIssueFilter builds an issue filter from query parameters
+func parseIssueFilter(values url.Values) (domain.IssueFilter, error) {
+	filter := domain.IssueFilter{
+		Statuses:    splitQueryValues(values["status"]),
+		Text:        strings.TrimSpace(values.Get("q")),
+		ProjectKeys: splitQueryValues(values["project"]),
+		Labels:      splitQueryValues(values["label"]),
+		LabelMatch:  strings.ToLower(values.Get("labelMatch")),
+	}
+
+	for _, assignee := range splitQueryValues(values["assignee"]) {
+		if strings.EqualFold(assignee, unassignedFilterValue) {
+			filter.Unassigned = true
+			continue
+		}
+		id, err := utils.ParseUintParam(assignee)
+		if err != nil {
+			return filter, domain.Validation(codeInvalidQuery, "Invalid assignee: "+assignee).WithDetail("field", "assignee")
+		}
+		filter.AssigneeIDs = append(filter.AssigneeIDs, id)
+	}
+
+	if overdueParam := values.Get("overdue"); overdueParam != "" {
+		overdue, err := strconv.ParseBool(overdueParam)
+		if err != nil {
+			return filter, domain.Validation(codeInvalidQuery, "Invalid overdue: expected true or false").WithDetail("field", "overdue")
+		}
+		filter.Overdue = &overdue
+	}
+
+	bounds := []struct {
+		param  string
+		target **time.Time
+	}{
+		{"createdAfter", &filter.CreatedAfter},
+		{"createdBefore", &filter.CreatedBefore},
+		{"updatedAfter", &filter.UpdatedAfter},
+		{"updatedBefore", &filter.UpdatedBefore},
+	}
+	for _, bound := range bounds {
+		value := values.Get(bound.param)
+		if value == "" {
+			continue
+		}
+		t, err := parseFilterTime(value)
+		if err != nil {
+			return filter, domain.Validation(codeInvalidQuery, "Invalid "+bound.param+": expected RFC 3339 time or YYYY-MM-DD date").WithDetail("field", bound.param)
+		}
+		*bound.target = &t
+	}
+
+	return filter, nil
+}
+
+// splitQueryValues flattens repeated and comma-separated query values, dropping empty entries
+func splitQueryValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
+}
+
+// parseFilterTime accepts an RFC 3339 timestamp or a date, which means midnight UTC
+func parseFilterTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"aoroa/internal/service"
+	"aoroa/pkg/handlers"
+	"aoroa/pkg/utils"
+
+	"github.com/gin-contrib/sse"
+)
+
+const (
+	// lastEventIDHeader carries the ID of the last event a reconnecting client received
+	lastEventIDHeader = "Last-Event-ID"
+	// streamResetEvent tells the client that missed events are gone and it should reload
+	streamResetEvent = "reset"
+	// streamHeartbeat is how often an idle stream sends a comment to keep proxies from closing it
+	streamHeartbeat = 15 * time.Second
+)
+
+// IssueStreamHandler streams issue changes as Server-Sent Events
+type IssueStreamHandler struct {
+	issueStream *service.IssueStream
+}
+
+// NewIssueStreamHandler creates a new IssueStreamHandler
+func NewIssueStreamHandler(issueStream *service.IssueStream) handlers.IssueStreamHandlerInterface {
+	return &IssueStreamHandler{
+		issueStream: issueStream,
+	}
+}
+
+// StreamIssues handles the issue event stream. The status and assignee query
+// parameters filter it like the issue list, and a Last-Event-ID header resumes it.
+// Issues of projects the caller is not a member of are left out.
+func (h *IssueStreamHandler) StreamIssues(ctx utils.HTTPContext) {
+	filter, err := parseIssueFilter(ctx.GetURL().Query())
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+
+	sub, err := h.issueStream.Subscribe(filter, actorID, ctx.GetHeader(lastEventIDHeader))
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+	defer sub.Close()
+
+	ctx.SetHeader("Content-Type", sse.ContentType)
+	ctx.SetHeader("Cache-Control", "no-cache")
+	ctx.SetHeader("Connection", "keep-alive")
+	// Keeps reverse proxies such as nginx from buffering the stream
+	ctx.SetHeader("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	if ctx.GetMethod() == http.MethodHead {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	done := ctx.Context().Done()
+
+	started := false
+	ctx.Stream(func(w io.Writer) bool {
+		if !started {
+			// Sends the headers along with what the client missed
+			started = true
+			if sub.Reset {
+				if err := sse.Encode(w, sse.Event{Event: streamResetEvent, Data: map[string]string{}}); err != nil {
+					return false
+				}
+			}
+			for _, event := range sub.Replay {
+				if err := writeStreamEvent(w, event); err != nil {
+					return false
+				}
+			}
+			return true
+		}
+
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return false
+			}
+			return writeStreamEvent(w, event) == nil
+		case <-heartbeat.C:
+			_, err := io.WriteString(w, ":\n\n")
+			return err == nil
+		case <-done:
+			return false
+		}
+	})
+}
+
+// writeStreamEvent writes an issue event named by its topic, with its stream ID as event ID
+func writeStreamEvent(w io.Writer, event service.IssueStreamEvent) error {
+	return sse.Encode(w, sse.Event{
+		Id:    strconv.FormatUint(event.ID, 10),
+		Event: event.Event.Topic(),
+		Data:  event.Event,
+	})
+}
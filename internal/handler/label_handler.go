@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"net/http"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/service"
+	"aoroa/pkg/handlers"
+	"aoroa/pkg/utils"
+)
+
+// LabelHandler implements label operations using interface-based approach
+type LabelHandler struct {
+	labelService *service.LabelService
+}
+
+// NewLabelHandler creates a new LabelHandler
+func NewLabelHandler(labelService *service.LabelService) handlers.LabelHandlerInterface {
+	return &LabelHandler{
+		labelService: labelService,
+	}
+}
+
+// CreateLabel handles label creation
+func (h *LabelHandler) CreateLabel(ctx utils.HTTPContext) {
+	var req domain.CreateLabelRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+
+	label, err := h.labelService.CreateLabel(req, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, label)
+}
+
+// GetLabel handles single label retrieval
+func (h *LabelHandler) GetLabel(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid label ID")
+	if !ok {
+		return
+	}
+
+	label, err := h.labelService.GetLabel(id)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, label)
+}
+
+// GetLabels handles label list retrieval
+func (h *LabelHandler) GetLabels(ctx utils.HTTPContext) {
+	labels, err := h.labelService.GetAllLabels()
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	response := domain.LabelsResponse{
+		Labels: make([]interface{}, len(labels)),
+	}
+	for i, label := range labels {
+		response.Labels[i] = label
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// UpdateLabel handles label updates
+func (h *LabelHandler) UpdateLabel(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid label ID")
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateLabelRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+
+	label, err := h.labelService.UpdateLabel(id, req, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, label)
+}
+
+// DeleteLabel handles label deletion, detaching the label from every issue
+func (h *LabelHandler) DeleteLabel(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid label ID")
+	if !ok {
+		return
+	}
+
+	actorID, ok := optionalActorID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.labelService.DeleteLabel(id, actorID); err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"aoroa/internal/domain"
+	"aoroa/pkg/utils"
+)
+
+// userIDHeader identifies the user performing a request
+const userIDHeader = "X-User-ID"
+
+// parseIDParam parses a numeric URL parameter, writing a 400 response when it is invalid
+func parseIDParam(ctx utils.HTTPContext, key, message string) (uint, bool) {
+	id, err := utils.ParseUintParam(ctx.GetParam(key))
+	if err != nil {
+		writeError(ctx, domain.Validation(codeInvalidID, message))
+		return 0, false
+	}
+	return id, true
+}
+
+// requireActorID reads the acting user from the X-User-ID header, writing a 400 response when it is missing or invalid
+func requireActorID(ctx utils.HTTPContext) (uint, bool) {
+	id, err := utils.ParseUintParam(ctx.GetHeader(userIDHeader))
+	if err != nil {
+		writeError(ctx, domain.Validation(codeInvalidActor, "Missing or invalid "+userIDHeader+" header").WithDetail("header", userIDHeader))
+		return 0, false
+	}
+	return id, true
+}
+
+// optionalActorID reads the acting user from the X-User-ID header if present,
+// writing a 400 response when the header is malformed
+func optionalActorID(ctx utils.HTTPContext) (*uint, bool) {
+	if ctx.GetHeader(userIDHeader) == "" {
+		return nil, true
+	}
+
+	id, ok := requireActorID(ctx)
+	if !ok {
+		return nil, false
+	}
+	return &id, true
+}
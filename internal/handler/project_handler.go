@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"net/http"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/service"
+	"aoroa/pkg/handlers"
+	"aoroa/pkg/utils"
+)
+
+// ProjectHandler implements project operations using interface-based approach
+type ProjectHandler struct {
+	projectService *service.ProjectService
+	issueService   *service.IssueService
+}
+
+// NewProjectHandler creates a new ProjectHandler
+func NewProjectHandler(projectService *service.ProjectService, issueService *service.IssueService) handlers.ProjectHandlerInterface {
+	return &ProjectHandler{
+		projectService: projectService,
+		issueService:   issueService,
+	}
+}
+
+// CreateProject handles project creation by an admin
+func (h *ProjectHandler) CreateProject(ctx utils.HTTPContext) {
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	var req domain.CreateProjectRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	project, err := h.projectService.CreateProject(req, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, project)
+}
+
+// GetProject handles single project retrieval
+func (h *ProjectHandler) GetProject(ctx utils.HTTPContext) {
+	project, err := h.projectService.GetProject(ctx.GetParam("key"))
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, project)
+}
+
+// GetProjects handles project list retrieval
+func (h *ProjectHandler) GetProjects(ctx utils.HTTPContext) {
+	projects, err := h.projectService.GetAllProjects()
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	response := domain.ProjectsResponse{
+		Projects: make([]interface{}, len(projects)),
+	}
+	for i, project := range projects {
+		response.Projects[i] = project
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// UpdateProject handles project updates by an admin
+func (h *ProjectHandler) UpdateProject(ctx utils.HTTPContext) {
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateProjectRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	project, err := h.projectService.UpdateProject(ctx.GetParam("key"), req, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, project)
+}
+
+// AddMember handles adding a member to a project
+func (h *ProjectHandler) AddMember(ctx utils.HTTPContext) {
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	var req domain.AddProjectMemberRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	project, err := h.projectService.AddMember(ctx.GetParam("key"), req.UserID, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, project)
+}
+
+// RemoveMember handles removing a member from a project
+func (h *ProjectHandler) RemoveMember(ctx utils.HTTPContext) {
+	userID, ok := parseIDParam(ctx, "userId", "Invalid user ID")
+	if !ok {
+		return
+	}
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	project, err := h.projectService.RemoveMember(ctx.GetParam("key"), userID, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, project)
+}
+
+// CreateProjectIssue handles issue creation within the project in the path
+func (h *ProjectHandler) CreateProjectIssue(ctx utils.HTTPContext) {
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	var req domain.CreateIssueRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+	req.ProjectKey = ctx.GetParam("key")
+	req.ActorID = &actorID
+
+	issue, err := h.issueService.CreateIssue(req)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	writeIssue(ctx, http.StatusCreated, issue)
+}
+
+// GetProjectIssue handles retrieval of an issue by its number within the project
+func (h *ProjectHandler) GetProjectIssue(ctx utils.HTTPContext) {
+	number, ok := parseIDParam(ctx, "number", "Invalid issue number")
+	if !ok {
+		return
+	}
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	issue, err := h.issueService.GetProjectIssue(ctx.GetParam("key"), number, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	writeIssueIfNoneMatch(ctx, issue)
+}
+
+// GetProjectIssues handles issue list retrieval within the project, accepting the same query as GetIssues
+func (h *ProjectHandler) GetProjectIssues(ctx utils.HTTPContext) {
+	query, err := parseIssueListQuery(ctx)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+	query.ActorID = &actorID
+	query.Filter.ProjectKeys = []string{ctx.GetParam("key")}
+
+	writeIssuePage(ctx, h.issueService, query)
+}
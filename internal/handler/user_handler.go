@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"net/http"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/service"
+	"aoroa/pkg/handlers"
+	"aoroa/pkg/utils"
+)
+
+// UserHandler implements user operations using interface-based approach
+type UserHandler struct {
+	userService *service.UserService
+}
+
+// NewUserHandler creates a new UserHandler
+func NewUserHandler(userService *service.UserService) handlers.UserHandlerInterface {
+	return &UserHandler{
+		userService: userService,
+	}
+}
+
+// CreateUser handles user creation
+func (h *UserHandler) CreateUser(ctx utils.HTTPContext) {
+	var req domain.CreateUserRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	user, err := h.userService.CreateUser(req)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, user)
+}
+
+// GetUser handles single user retrieval
+func (h *UserHandler) GetUser(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid user ID")
+	if !ok {
+		return
+	}
+
+	user, exists := h.userService.GetUser(id)
+	if !exists {
+		writeError(ctx, domain.ErrUserNotFound)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user)
+}
+
+// GetUsers handles user list retrieval
+func (h *UserHandler) GetUsers(ctx utils.HTTPContext) {
+	users := h.userService.GetAllUsers()
+
+	response := domain.UsersResponse{
+		Users: make([]interface{}, len(users)),
+	}
+	for i, user := range users {
+		response.Users[i] = user
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// UpdateUser handles user updates
+func (h *UserHandler) UpdateUser(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid user ID")
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateUserRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	user, err := h.userService.UpdateUser(id, actorID, req)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user)
+}
+
+// DeactivateUser handles user deactivation
+func (h *UserHandler) DeactivateUser(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid user ID")
+	if !ok {
+		return
+	}
+
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	user, err := h.userService.DeactivateUser(id, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, user)
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"net/http"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/service"
+	"aoroa/pkg/handlers"
+	"aoroa/pkg/utils"
+)
+
+// WebhookHandler implements webhook operations using interface-based approach.
+// Every operation requires an admin in the X-User-ID header.
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+}
+
+// NewWebhookHandler creates a new WebhookHandler
+func NewWebhookHandler(webhookService *service.WebhookService) handlers.WebhookHandlerInterface {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// CreateWebhook handles webhook subscription
+func (h *WebhookHandler) CreateWebhook(ctx utils.HTTPContext) {
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	var req domain.CreateWebhookRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(req, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, webhook)
+}
+
+// GetWebhook handles single webhook retrieval
+func (h *WebhookHandler) GetWebhook(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid webhook ID")
+	if !ok {
+		return
+	}
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	webhook, err := h.webhookService.GetWebhook(id, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webhook)
+}
+
+// GetWebhooks handles webhook list retrieval
+func (h *WebhookHandler) GetWebhooks(ctx utils.HTTPContext) {
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	webhooks, err := h.webhookService.GetWebhooks(actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	response := domain.WebhooksResponse{
+		Webhooks: make([]interface{}, len(webhooks)),
+	}
+	for i, webhook := range webhooks {
+		response.Webhooks[i] = webhook
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// UpdateWebhook handles webhook updates
+func (h *WebhookHandler) UpdateWebhook(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid webhook ID")
+	if !ok {
+		return
+	}
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	var req domain.UpdateWebhookRequest
+	if err := ctx.BindJSON(&req); err != nil {
+		writeError(ctx, invalidRequest(err))
+		return
+	}
+
+	webhook, err := h.webhookService.UpdateWebhook(id, req, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webhook)
+}
+
+// DeleteWebhook handles webhook removal
+func (h *WebhookHandler) DeleteWebhook(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid webhook ID")
+	if !ok {
+		return
+	}
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.DeleteWebhook(id, actorID); err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// GetWebhookDeliveries handles retrieval of a webhook's delivery log
+func (h *WebhookHandler) GetWebhookDeliveries(ctx utils.HTTPContext) {
+	id, ok := parseIDParam(ctx, "id", "Invalid webhook ID")
+	if !ok {
+		return
+	}
+	actorID, ok := requireActorID(ctx)
+	if !ok {
+		return
+	}
+
+	deliveries, err := h.webhookService.GetDeliveries(id, actorID)
+	if err != nil {
+		writeError(ctx, err)
+		return
+	}
+
+	response := domain.WebhookDeliveriesResponse{
+		Deliveries: make([]interface{}, len(deliveries)),
+	}
+	for i, delivery := range deliveries {
+		response.Deliveries[i] = delivery
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
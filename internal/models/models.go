@@ -1,21 +1,159 @@
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // User represents a user in the system
 type User struct {
-	ID    uint   `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID            uint       `json:"id"`
+	Name          string     `json:"name"`
+	Email         string     `json:"email"`
+	Role          string     `json:"role,omitempty"` // "admin" or "member"; empty means member
+	DeactivatedAt *time.Time `json:"deactivatedAt,omitempty"`
+}
+
+// IsActive reports whether the user has not been deactivated
+func (u *User) IsActive() bool {
+	return u.DeactivatedAt == nil
 }
 
 // Issue represents an issue in the system
 type Issue struct {
+	ID          uint       `json:"id"`
+	ProjectID   uint       `json:"projectId,omitempty"` // zero for issues created outside a project
+	Number      uint       `json:"number,omitempty"`    // sequential number within the project
+	Key         string     `json:"key,omitempty"`       // project key and number, e.g. "WEB-42"
+	ParentID    *uint      `json:"parentId,omitempty"`  // parent issue of a sub-task
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	Priority    string     `json:"priority"`           // P0 (most urgent) to P4
+	Severity    string     `json:"severity,omitempty"` // critical, major, minor or trivial
+	User        *User      `json:"user,omitempty"`
+	LabelIDs    []uint     `json:"labelIds,omitempty"` // attached labels in ascending ID order
+	StartDate   *time.Time `json:"startDate,omitempty"`
+	DueDate     *time.Time `json:"dueDate,omitempty"` // open issues past this time are overdue
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	// Version counts the changes to the issue and is served as its ETag
+	Version uint `json:"version"`
+
+	// CommentCount is derived from the issue's comments when the issue is read
+	CommentCount int `json:"commentCount"`
+	// Labels is derived from LabelIDs when the issue is read
+	Labels []*Label `json:"labels"`
+	// Progress rolls up the issue's sub-tasks when the issue is read; nil without sub-tasks
+	Progress *IssueProgress `json:"progress,omitempty"`
+}
+
+// IssueProgress summarizes the sub-tasks of an issue
+type IssueProgress struct {
+	Total   int `json:"total"`
+	Closed  int `json:"closed"`  // sub-tasks in a final state
+	Percent int `json:"percent"` // closed share of total, rounded down
+}
+
+// Label categorizes issues; an issue may carry many labels and a label many issues
+type Label struct {
 	ID          uint      `json:"id"`
-	Title       string    `json:"title"`
+	Name        string    `json:"name"`
+	Color       string    `json:"color"` // hex color such as "#d73a4a"
 	Description string    `json:"description"`
-	Status      string    `json:"status"`
-	User        *User     `json:"user,omitempty"`
 	CreatedAt   time.Time `json:"createdAt"`
 	UpdatedAt   time.Time `json:"updatedAt"`
 }
+
+// Project groups issues under a key and numbers them sequentially
+type Project struct {
+	ID          uint      `json:"id"`
+	Key         string    `json:"key"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	MemberIDs   []uint    `json:"memberIds"`
+	LastNumber  uint      `json:"lastNumber"` // number of the most recently created issue
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// HasMember reports whether the user belongs to the project
+func (p *Project) HasMember(userID uint) bool {
+	for _, id := range p.MemberIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// IssueLink is a typed relation between two issues. Links are stored in their
+// forward direction (blocks, duplicates, relates_to) from SourceID to TargetID.
+type IssueLink struct {
+	ID        uint      `json:"id"`
+	SourceID  uint      `json:"sourceId"`
+	TargetID  uint      `json:"targetId"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Change is an entry of the changes feed. Seq increases by one with every
+// committed change, so a client can resume syncing after the last one it applied.
+type Change struct {
+	Seq      uint       `json:"seq"`
+	Entity   string     `json:"entity"` // issue or issueLink
+	EntityID uint       `json:"entityId"`
+	Op       string     `json:"op"`              // created, updated or deleted
+	Issue    *Issue     `json:"issue,omitempty"` // the issue after the change
+	Link     *IssueLink `json:"link,omitempty"`  // the link as created, or as it was before deletion
+	Actor    *User      `json:"actor,omitempty"` // nil when the change was made by the system or an anonymous client
+	At       time.Time  `json:"at"`
+}
+
+// Webhook subscribes an HTTP endpoint to domain events
+type Webhook struct {
+	ID        uint      `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`           // event topics; every event when empty
+	Secret    string    `json:"secret,omitempty"` // HMAC-SHA256 signing key; never returned by the API
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WebhookDelivery records the delivery of one event to one webhook, including its retries
+type WebhookDelivery struct {
+	ID            uint            `json:"id"`
+	WebhookID     uint            `json:"webhookId"`
+	Event         string          `json:"event"`
+	Payload       json.RawMessage `json:"payload"`
+	Status        string          `json:"status"` // pending, delivered or dead
+	Attempts      int             `json:"attempts"`
+	ResponseCode  int             `json:"responseCode,omitempty"` // HTTP status of the last attempt
+	LastError     string          `json:"lastError,omitempty"`
+	LastAttemptAt *time.Time      `json:"lastAttemptAt,omitempty"`
+	NextAttemptAt *time.Time      `json:"nextAttemptAt,omitempty"` // set while a retry is scheduled
+	DeliveredAt   *time.Time      `json:"deliveredAt,omitempty"`
+	CreatedAt     time.Time       `json:"createdAt"`
+}
+
+// Comment represents a comment left on an issue
+type Comment struct {
+	ID        uint      `json:"id"`
+	IssueID   uint      `json:"issueId"`
+	Author    *User     `json:"author"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// HistoryEntry is an immutable record of a single field change on an issue
+type HistoryEntry struct {
+	ID       uint      `json:"id"`
+	IssueID  uint      `json:"issueId"`
+	Field    string    `json:"field"`
+	OldValue *string   `json:"oldValue"`
+	NewValue *string   `json:"newValue"`
+	Actor    *User     `json:"actor,omitempty"` // nil when the change was made by the system or an anonymous client
+	At       time.Time `json:"at"`
+}
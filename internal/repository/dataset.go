@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"aoroa/internal/models"
+)
+
+// Table names used in the durable log
+const (
+	tableIssues     = "issues"
+	tableUsers      = "users"
+	tableComments   = "comments"
+	tableHistory    = "history"
+	tableChanges    = "changes"
+	tableProjects   = "projects"
+	tableLabels     = "labels"
+	tableLinks      = "links"
+	tableWebhooks   = "webhooks"
+	tableDeliveries = "webhook_deliveries"
+)
+
+// table is an ID-keyed collection of records
+type table[T any] struct {
+	Rows   map[uint]*T `json:"rows"`
+	NextID uint        `json:"nextId"`
+}
+
+func newTable[T any]() *table[T] {
+	return &table[T]{Rows: make(map[uint]*T), NextID: 1}
+}
+
+// get returns a shallow copy of the record with the given ID
+func (t *table[T]) get(id uint) (*T, bool) {
+	row, exists := t.Rows[id]
+	if !exists {
+		return nil, false
+	}
+	clone := *row
+	return &clone, true
+}
+
+// list returns copies of all records ordered by ID
+func (t *table[T]) list() []*T {
+	ids := make([]uint, 0, len(t.Rows))
+	for id := range t.Rows {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	result := make([]*T, 0, len(ids))
+	for _, id := range ids {
+		clone := *t.Rows[id]
+		result = append(result, &clone)
+	}
+	return result
+}
+
+// allocate reserves the next free ID
+func (t *table[T]) allocate() uint {
+	return t.NextID
+}
+
+// put stores a copy of row under id and returns a function undoing the change
+func (t *table[T]) put(id uint, row *T) func() {
+	previous, existed := t.Rows[id]
+	previousNextID := t.NextID
+
+	clone := *row
+	t.Rows[id] = &clone
+	if id >= t.NextID {
+		t.NextID = id + 1
+	}
+
+	return func() {
+		if existed {
+			t.Rows[id] = previous
+		} else {
+			delete(t.Rows, id)
+		}
+		t.NextID = previousNextID
+	}
+}
+
+// remove deletes the record with the given ID and returns a function undoing the change
+func (t *table[T]) remove(id uint) func() {
+	previous, existed := t.Rows[id]
+	delete(t.Rows, id)
+
+	return func() {
+		if existed {
+			t.Rows[id] = previous
+		}
+	}
+}
+
+// apply replays a logged operation against the table
+func (t *table[T]) apply(o op) error {
+	if o.Delete {
+		t.remove(o.ID)
+		return nil
+	}
+
+	var row T
+	if err := json.Unmarshal(o.Data, &row); err != nil {
+		return err
+	}
+	t.put(o.ID, &row)
+	return nil
+}
+
+// replayable is implemented by every table so logged operations can be applied generically
+type replayable interface {
+	apply(o op) error
+}
+
+// dataset holds every table of a store
+type dataset struct {
+	Issues     *table[models.Issue]           `json:"issues"`
+	Users      *table[models.User]            `json:"users"`
+	Comments   *table[models.Comment]         `json:"comments"`
+	History    *table[models.HistoryEntry]    `json:"history"`
+	Changes    *table[models.Change]          `json:"changes"`
+	Projects   *table[models.Project]         `json:"projects"`
+	Labels     *table[models.Label]           `json:"labels"`
+	Links      *table[models.IssueLink]       `json:"links"`
+	Webhooks   *table[models.Webhook]         `json:"webhooks"`
+	Deliveries *table[models.WebhookDelivery] `json:"webhookDeliveries"`
+}
+
+func newDataset() *dataset {
+	return &dataset{
+		Issues:     newTable[models.Issue](),
+		Users:      newTable[models.User](),
+		Comments:   newTable[models.Comment](),
+		History:    newTable[models.HistoryEntry](),
+		Changes:    newTable[models.Change](),
+		Projects:   newTable[models.Project](),
+		Labels:     newTable[models.Label](),
+		Links:      newTable[models.IssueLink](),
+		Webhooks:   newTable[models.Webhook](),
+		Deliveries: newTable[models.WebhookDelivery](),
+	}
+}
+
+// tables maps log table names to their tables
+func (d *dataset) tables() map[string]replayable {
+	return map[string]replayable{
+		tableIssues:     d.Issues,
+		tableUsers:      d.Users,
+		tableComments:   d.Comments,
+		tableHistory:    d.History,
+		tableChanges:    d.Changes,
+		tableProjects:   d.Projects,
+		tableLabels:     d.Labels,
+		tableLinks:      d.Links,
+		tableWebhooks:   d.Webhooks,
+		tableDeliveries: d.Deliveries,
+	}
+}
+
+// apply replays a single logged operation
+func (d *dataset) apply(o op) error {
+	t, exists := d.tables()[o.Table]
+	if !exists {
+		return fmt.Errorf("unknown table %q", o.Table)
+	}
+	return t.apply(o)
+}
+
+// op is a single change recorded by a read-write transaction
+type op struct {
+	Table  string          `json:"t"`
+	ID     uint            `json:"id"`
+	Delete bool            `json:"del,omitempty"`
+	Data   json.RawMessage `json:"d,omitempty"`
+
+	// value is the record written by put; it is encoded lazily when the op is persisted
+	value interface{}
+}
+
+// encode fills Data from the recorded value
+func (o *op) encode() error {
+	if o.Delete || o.value == nil {
+		return nil
+	}
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return err
+	}
+	o.Data = data
+	return nil
+}
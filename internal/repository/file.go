@@ -0,0 +1,345 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	snapshotFileName = "snapshot.json"
+	logFileName      = "wal.log"
+)
+
+// FileStoreOptions controls how often the file store compacts its log
+type FileStoreOptions struct {
+	// SnapshotThreshold is the number of logged transactions after which a snapshot is written.
+	// Zero disables threshold-based snapshots.
+	SnapshotThreshold int
+	// SnapshotInterval is the period of background snapshots. Zero disables them.
+	SnapshotInterval time.Duration
+}
+
+// DefaultFileStoreOptions returns the options used when none are specified
+func DefaultFileStoreOptions() FileStoreOptions {
+	return FileStoreOptions{
+		SnapshotThreshold: 1000,
+		SnapshotInterval:  5 * time.Minute,
+	}
+}
+
+// logFile is the append-only log as the store uses it; *os.File implements it
+type logFile interface {
+	io.WriteCloser
+	Sync() error
+	Truncate(size int64) error
+	Stat() (os.FileInfo, error)
+}
+
+// logRecord is one committed transaction in the append-only log
+type logRecord struct {
+	Ops []op `json:"ops"`
+}
+
+// FileStore is a durable store backed by an append-only log and periodic snapshots.
+// Every committed transaction is appended to the log and synced before Update returns;
+// snapshots capture the full dataset so the log can be truncated.
+type FileStore struct {
+	mu      sync.RWMutex
+	dir     string
+	opts    FileStoreOptions
+	data    *dataset
+	logFile logFile
+	pending int   // transactions logged since the last snapshot
+	failed  error // set when the log could not be restored after a failed write
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// OpenFileStore opens (or creates) a file store in dir and restores its state
+func OpenFileStore(dir string, opts FileStoreOptions) (*FileStore, error) {
+	if dir == "" {
+		return nil, errors.New("file store requires a data directory")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	s := &FileStore{
+		dir:  dir,
+		opts: opts,
+		data: newDataset(),
+	}
+
+	if err := s.loadSnapshot(); err != nil {
+		return nil, fmt.Errorf("load snapshot: %w", err)
+	}
+	if err := s.replayLog(); err != nil {
+		return nil, fmt.Errorf("replay log: %w", err)
+	}
+
+	logFile, err := os.OpenFile(s.path(logFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	s.logFile = logFile
+
+	if opts.SnapshotInterval > 0 {
+		s.stop = make(chan struct{})
+		s.done = make(chan struct{})
+		go s.snapshotLoop()
+	}
+
+	return s, nil
+}
+
+// View runs fn in a read-only transaction
+func (s *FileStore) View(fn func(tx Tx) error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return fn(newTx(s.data, false))
+}
+
+// Update runs fn in a read-write transaction. Changes are appended to the log
+// and synced to disk before Update returns; on any error they are rolled back.
+func (s *FileStore) Update(fn func(tx Tx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.logFile == nil {
+		return errors.New("file store is closed")
+	}
+	if s.failed != nil {
+		return fmt.Errorf("file store failed: %w", s.failed)
+	}
+
+	t := newTx(s.data, true)
+	if err := fn(t); err != nil {
+		t.rollback()
+		return err
+	}
+	if len(t.ops) == 0 {
+		return nil
+	}
+
+	if err := s.appendLog(t.ops); err != nil {
+		t.rollback()
+		return err
+	}
+
+	s.pending++
+	if s.opts.SnapshotThreshold > 0 && s.pending >= s.opts.SnapshotThreshold {
+		if err := s.snapshotLocked(); err != nil {
+			// The log still holds every change, so a failed snapshot is not fatal
+			log.Printf("file store: snapshot failed: %v", err)
+		}
+	}
+	return nil
+}
+
+// Snapshot writes the full dataset to disk and truncates the log
+func (s *FileStore) Snapshot() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.snapshotLocked()
+}
+
+// Close stops background snapshots, writes a final snapshot and closes the log
+func (s *FileStore) Close() error {
+	if s.stop != nil {
+		close(s.stop)
+		<-s.done
+		s.stop = nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.logFile == nil {
+		return nil
+	}
+
+	snapshotErr := s.snapshotLocked()
+	closeErr := s.logFile.Close()
+	s.logFile = nil
+
+	return errors.Join(snapshotErr, closeErr)
+}
+
+func (s *FileStore) path(name string) string {
+	return filepath.Join(s.dir, name)
+}
+
+// appendLog writes one transaction to the log and syncs it. When that fails,
+// the log is truncated back to where the record started so that the next
+// record does not follow a partial one; if even that fails, the store is
+// marked failed and refuses further updates.
+func (s *FileStore) appendLog(ops []op) error {
+	for i := range ops {
+		if err := ops[i].encode(); err != nil {
+			return err
+		}
+	}
+
+	line, err := json.Marshal(logRecord{Ops: ops})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	info, err := s.logFile.Stat()
+	if err != nil {
+		return err
+	}
+	offset := info.Size()
+
+	_, err = s.logFile.Write(line)
+	if err == nil {
+		err = s.logFile.Sync()
+	}
+	if err != nil {
+		if truncErr := s.logFile.Truncate(offset); truncErr != nil {
+			s.failed = errors.Join(err, truncErr)
+			log.Printf("file store: cannot remove partial log record at offset %d: %v", offset, s.failed)
+		}
+		return err
+	}
+	return nil
+}
+
+// snapshotLocked writes the dataset atomically and truncates the log. Callers must hold mu.
+func (s *FileStore) snapshotLocked() error {
+	if s.pending == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(s.data)
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path(snapshotFileName + ".tmp")
+	if err := writeFileSync(tmp, data); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, s.path(snapshotFileName)); err != nil {
+		return err
+	}
+
+	// Log entries are idempotent puts and deletes, so a crash between the
+	// rename and the truncate only replays changes already in the snapshot.
+	if s.logFile != nil {
+		if err := s.logFile.Truncate(0); err != nil {
+			return err
+		}
+	}
+	s.pending = 0
+	return nil
+}
+
+// snapshotLoop writes snapshots on a fixed interval until Close is called
+func (s *FileStore) snapshotLoop() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.opts.SnapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Snapshot(); err != nil {
+				log.Printf("file store: periodic snapshot failed: %v", err)
+			}
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// loadSnapshot restores the dataset from the latest snapshot, if any
+func (s *FileStore) loadSnapshot() error {
+	data, err := os.ReadFile(s.path(snapshotFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	restored := newDataset()
+	if err := json.Unmarshal(data, restored); err != nil {
+		return err
+	}
+	s.data = restored
+	return nil
+}
+
+// replayLog applies logged transactions on top of the snapshot. A trailing
+// partial line left by a crash is discarded.
+func (s *FileStore) replayLog() error {
+	f, err := os.OpenFile(s.path(logFileName), os.O_RDWR, 0o644)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	var offset int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				log.Printf("file store: discarding incomplete log record at offset %d", offset)
+				return f.Truncate(offset)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var record logRecord
+		if err := json.Unmarshal(bytes.TrimSpace(line), &record); err != nil {
+			return fmt.Errorf("corrupt log record at offset %d: %w", offset, err)
+		}
+		for _, o := range record.Ops {
+			if err := s.data.apply(o); err != nil {
+				return err
+			}
+		}
+
+		offset += int64(len(line))
+		s.pending++
+	}
+}
+
+// writeFileSync writes data to path and syncs it to disk
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,40 @@
+package repository
+
+import "sync"
+
+// MemoryStore keeps all records in process memory. Data is lost on restart.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	data *dataset
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{data: newDataset()}
+}
+
+// View runs fn in a read-only transaction
+func (s *MemoryStore) View(fn func(tx Tx) error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return fn(newTx(s.data, false))
+}
+
+// Update runs fn in a read-write transaction, rolling back on error
+func (s *MemoryStore) Update(fn func(tx Tx) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := newTx(s.data, true)
+	if err := fn(t); err != nil {
+		t.rollback()
+		return err
+	}
+	return nil
+}
+
+// Close is a no-op for the in-memory store
+func (s *MemoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"errors"
+
+	"aoroa/internal/models"
+)
+
+// Storage backend names accepted by Open
+const (
+	BackendMemory = "memory"
+	BackendFile   = "file"
+)
+
+var (
+	// ErrNotFound is returned when a record does not exist
+	ErrNotFound = errors.New("record not found")
+	// ErrAlreadyExists is returned when creating a record with an ID that is taken
+	ErrAlreadyExists = errors.New("record already exists")
+	// ErrReadOnly is returned when writing inside a read-only transaction
+	ErrReadOnly = errors.New("read-only transaction")
+)
+
+// IssueRepository defines persistence operations for issues
+type IssueRepository interface {
+	Get(id uint) (*models.Issue, error)
+	List() ([]*models.Issue, error)
+	// Create stores a new issue at version 1, assigning the next ID when issue.ID is zero
+	Create(issue *models.Issue) error
+	// Update stores an existing issue, setting issue.Version to the stored version plus one
+	Update(issue *models.Issue) error
+}
+
+// UserRepository defines persistence operations for users
+type UserRepository interface {
+	Get(id uint) (*models.User, error)
+	List() ([]*models.User, error)
+	// Create stores a new user, assigning the next ID when user.ID is zero
+	Create(user *models.User) error
+	Update(user *models.User) error
+}
+
+// CommentRepository defines persistence operations for issue comments
+type CommentRepository interface {
+	Get(id uint) (*models.Comment, error)
+	// ListByIssue returns the comments of an issue ordered by ID
+	ListByIssue(issueID uint) ([]*models.Comment, error)
+	// CountByIssue returns the number of comments per issue ID
+	CountByIssue() (map[uint]int, error)
+	// Create stores a new comment, assigning the next ID when comment.ID is zero
+	Create(comment *models.Comment) error
+	Update(comment *models.Comment) error
+	Delete(id uint) error
+}
+
+// HistoryRepository stores the append-only change history of issues
+type HistoryRepository interface {
+	// Append stores a new entry, assigning its ID. Entries are never updated or deleted.
+	Append(entry *models.HistoryEntry) error
+	// ListByIssue returns the history of an issue in the order it was recorded
+	ListByIssue(issueID uint) ([]*models.HistoryEntry, error)
+}
+
+// ChangeRepository stores the append-only changes feed
+type ChangeRepository interface {
+	// Append stores a new change, assigning the next sequence number. Changes are never updated or deleted.
+	Append(change *models.Change) error
+	// ListSince returns up to limit changes with a sequence number above since, in order
+	ListSince(since uint, limit int) ([]*models.Change, error)
+	// LastSeq returns the sequence number of the latest change, zero when there is none
+	LastSeq() (uint, error)
+}
+
+// ProjectRepository defines persistence operations for projects
+type ProjectRepository interface {
+	Get(id uint) (*models.Project, error)
+	List() ([]*models.Project, error)
+	// Create stores a new project, assigning the next ID when project.ID is zero
+	Create(project *models.Project) error
+	Update(project *models.Project) error
+}
+
+// LabelRepository defines persistence operations for labels
+type LabelRepository interface {
+	Get(id uint) (*models.Label, error)
+	List() ([]*models.Label, error)
+	// Create stores a new label, assigning the next ID when label.ID is zero
+	Create(label *models.Label) error
+	Update(label *models.Label) error
+	Delete(id uint) error
+}
+
+// LinkRepository defines persistence operations for issue links
+type LinkRepository interface {
+	Get(id uint) (*models.IssueLink, error)
+	List() ([]*models.IssueLink, error)
+	// ListByIssue returns the links with the issue at either end, ordered by ID
+	ListByIssue(issueID uint) ([]*models.IssueLink, error)
+	// Create stores a new link, assigning the next ID when link.ID is zero
+	Create(link *models.IssueLink) error
+	Delete(id uint) error
+}
+
+// WebhookRepository defines persistence operations for webhook subscriptions
+type WebhookRepository interface {
+	Get(id uint) (*models.Webhook, error)
+	List() ([]*models.Webhook, error)
+	// Create stores a new webhook, assigning the next ID when webhook.ID is zero
+	Create(webhook *models.Webhook) error
+	Update(webhook *models.Webhook) error
+	Delete(id uint) error
+}
+
+// DeliveryRepository defines persistence operations for the webhook delivery log
+type DeliveryRepository interface {
+	Get(id uint) (*models.WebhookDelivery, error)
+	List() ([]*models.WebhookDelivery, error)
+	// ListByWebhook returns the deliveries of a webhook ordered by ID
+	ListByWebhook(webhookID uint) ([]*models.WebhookDelivery, error)
+	// Create stores a new delivery, assigning the next ID when delivery.ID is zero
+	Create(delivery *models.WebhookDelivery) error
+	Update(delivery *models.WebhookDelivery) error
+	Delete(id uint) error
+}
+
+// Tx gives access to the repositories within a single transaction.
+// Records returned by a Tx are copies; changes must be written back with Update.
+type Tx interface {
+	Issues() IssueRepository
+	Users() UserRepository
+	Comments() CommentRepository
+	History() HistoryRepository
+	Changes() ChangeRepository
+	Projects() ProjectRepository
+	Labels() LabelRepository
+	Links() LinkRepository
+	Webhooks() WebhookRepository
+	Deliveries() DeliveryRepository
+}
+
+// Store is a transactional container for all repositories
+type Store interface {
+	// View runs fn in a read-only transaction
+	View(fn func(tx Tx) error) error
+	// Update runs fn in a read-write transaction. If fn returns an error
+	// every change made through tx is rolled back.
+	Update(fn func(tx Tx) error) error
+	// Close releases resources held by the store
+	Close() error
+}
+
+// Open creates a store for the given backend name
+func Open(backend, dataDir string) (Store, error) {
+	switch backend {
+	case "", BackendMemory:
+		return NewMemoryStore(), nil
+	case BackendFile:
+		return OpenFileStore(dataDir, DefaultFileStoreOptions())
+	default:
+		return nil, errors.New("unknown storage backend: " + backend)
+	}
+}
@@ -0,0 +1,301 @@
+package repository
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"aoroa/internal/models"
+)
+
+func createIssue(t *testing.T, store Store, title string) *models.Issue {
+	t.Helper()
+
+	issue := &models.Issue{Title: title, Status: "PENDING", CreatedAt: time.Now()}
+	err := store.Update(func(tx Tx) error {
+		return tx.Issues().Create(issue)
+	})
+	if err != nil {
+		t.Fatalf("Failed to create issue: %v", err)
+	}
+	return issue
+}
+
+func TestMemoryStoreCreateAssignsSequentialIDs(t *testing.T) {
+	store := NewMemoryStore()
+
+	first := createIssue(t, store, "first")
+	second := createIssue(t, store, "second")
+
+	if first.ID != 1 || second.ID != 2 {
+		t.Errorf("Expected IDs 1 and 2, got %d and %d", first.ID, second.ID)
+	}
+}
+
+func TestMemoryStoreReturnsCopies(t *testing.T) {
+	store := NewMemoryStore()
+	created := createIssue(t, store, "original")
+
+	created.Title = "mutated outside the store"
+
+	store.View(func(tx Tx) error {
+		issue, err := tx.Issues().Get(created.ID)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if issue.Title != "original" {
+			t.Errorf("Expected stored title to be unchanged, got %q", issue.Title)
+		}
+		return nil
+	})
+}
+
+func TestMemoryStoreRollsBackFailedTransaction(t *testing.T) {
+	store := NewMemoryStore()
+	created := createIssue(t, store, "original")
+
+	errAbort := errors.New("abort")
+	err := store.Update(func(tx Tx) error {
+		issue, _ := tx.Issues().Get(created.ID)
+		issue.Title = "changed"
+		if err := tx.Issues().Update(issue); err != nil {
+			return err
+		}
+		if err := tx.Issues().Create(&models.Issue{Title: "new"}); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("Expected abort error, got %v", err)
+	}
+
+	store.View(func(tx Tx) error {
+		issues, _ := tx.Issues().List()
+		if len(issues) != 1 {
+			t.Fatalf("Expected 1 issue after rollback, got %d", len(issues))
+		}
+		if issues[0].Title != "original" {
+			t.Errorf("Expected title to be rolled back, got %q", issues[0].Title)
+		}
+		return nil
+	})
+
+	// The rolled back ID must be reused
+	if next := createIssue(t, store, "next"); next.ID != 2 {
+		t.Errorf("Expected next ID 2 after rollback, got %d", next.ID)
+	}
+}
+
+func TestMemoryStoreViewIsReadOnly(t *testing.T) {
+	store := NewMemoryStore()
+
+	err := store.View(func(tx Tx) error {
+		return tx.Users().Create(&models.User{Name: "reader"})
+	})
+	if !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Expected ErrReadOnly, got %v", err)
+	}
+}
+
+func TestFileStoreRestoresFromLog(t *testing.T) {
+	dir := t.TempDir()
+	opts := FileStoreOptions{}
+
+	store, err := OpenFileStore(dir, opts)
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	createIssue(t, store, "first")
+	second := createIssue(t, store, "second")
+
+	// Simulate a crash: drop the store without a final snapshot
+	store.logFile.Close()
+
+	reopened, err := OpenFileStore(dir, opts)
+	if err != nil {
+		t.Fatalf("Failed to reopen store: %v", err)
+	}
+	defer reopened.Close()
+
+	reopened.View(func(tx Tx) error {
+		issue, err := tx.Issues().Get(second.ID)
+		if err != nil {
+			t.Fatalf("Expected issue %d to be restored: %v", second.ID, err)
+		}
+		if issue.Title != "second" {
+			t.Errorf("Expected title 'second', got %q", issue.Title)
+		}
+		return nil
+	})
+
+	if next := createIssue(t, reopened, "third"); next.ID != 3 {
+		t.Errorf("Expected next ID 3 after restore, got %d", next.ID)
+	}
+}
+
+func TestFileStoreSnapshotTruncatesLog(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := OpenFileStore(dir, FileStoreOptions{SnapshotThreshold: 2})
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	createIssue(t, store, "first")
+	createIssue(t, store, "second")
+
+	info, err := os.Stat(filepath.Join(dir, logFileName))
+	if err != nil {
+		t.Fatalf("Failed to stat log: %v", err)
+	}
+	if info.Size() != 0 {
+		t.Errorf("Expected log to be truncated after snapshot, size %d", info.Size())
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("Failed to close store: %v", err)
+	}
+
+	reopened, err := OpenFileStore(dir, FileStoreOptions{})
+	if err != nil {
+		t.Fatalf("Failed to reopen store: %v", err)
+	}
+	defer reopened.Close()
+
+	reopened.View(func(tx Tx) error {
+		issues, _ := tx.Issues().List()
+		if len(issues) != 2 {
+			t.Errorf("Expected 2 issues from snapshot, got %d", len(issues))
+		}
+		return nil
+	})
+}
+
+func TestFileStoreDiscardsPartialLogRecord(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := OpenFileStore(dir, FileStoreOptions{})
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	createIssue(t, store, "complete")
+	store.logFile.Write([]byte(`{"ops":[{"t":"issues","id":2,"d":{"ti`))
+	store.logFile.Close()
+
+	reopened, err := OpenFileStore(dir, FileStoreOptions{})
+	if err != nil {
+		t.Fatalf("Failed to reopen store with partial record: %v", err)
+	}
+	defer reopened.Close()
+
+	if next := createIssue(t, reopened, "after crash"); next.ID != 2 {
+		t.Errorf("Expected next ID 2, got %d", next.ID)
+	}
+}
+
+// failingLog writes half of a record and then fails, like a full disk
+type failingLog struct {
+	logFile
+	truncateErr error
+}
+
+func (f *failingLog) Write(p []byte) (int, error) {
+	n, _ := f.logFile.Write(p[:len(p)/2])
+	return n, errors.New("disk full")
+}
+
+func (f *failingLog) Truncate(size int64) error {
+	if f.truncateErr != nil {
+		return f.truncateErr
+	}
+	return f.logFile.Truncate(size)
+}
+
+func TestFileStoreRemovesPartialRecordOfFailedWrite(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := OpenFileStore(dir, FileStoreOptions{})
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	createIssue(t, store, "first")
+
+	healthy := store.logFile
+	store.logFile = &failingLog{logFile: healthy}
+	err = store.Update(func(tx Tx) error {
+		return tx.Issues().Create(&models.Issue{Title: "lost"})
+	})
+	if err == nil {
+		t.Fatal("Expected the failed write to be reported")
+	}
+	store.logFile = healthy
+	createIssue(t, store, "second")
+
+	// Simulate a crash: drop the store without a final snapshot
+	store.logFile.Close()
+
+	reopened, err := OpenFileStore(dir, FileStoreOptions{})
+	if err != nil {
+		t.Fatalf("Failed to reopen store after a failed write: %v", err)
+	}
+	defer reopened.Close()
+
+	reopened.View(func(tx Tx) error {
+		issues, _ := tx.Issues().List()
+		if len(issues) != 2 || issues[0].Title != "first" || issues[1].Title != "second" {
+			t.Errorf("Expected the first and second issue, got %d issues", len(issues))
+		}
+		return nil
+	})
+}
+
+func TestFileStoreFailsWhenPartialRecordRemains(t *testing.T) {
+	store, err := OpenFileStore(t.TempDir(), FileStoreOptions{})
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	healthy := store.logFile
+	store.logFile = &failingLog{logFile: healthy, truncateErr: errors.New("read-only file system")}
+	store.Update(func(tx Tx) error {
+		return tx.Issues().Create(&models.Issue{Title: "lost"})
+	})
+	store.logFile = healthy
+
+	err = store.Update(func(tx Tx) error {
+		return tx.Issues().Create(&models.Issue{Title: "refused"})
+	})
+	if err == nil {
+		t.Fatal("Expected a failed store to refuse updates")
+	}
+}
+
+func TestMemoryStoreVersionsIssues(t *testing.T) {
+	store := NewMemoryStore()
+	created := createIssue(t, store, "original")
+	if created.Version != 1 {
+		t.Fatalf("Expected version 1 on creation, got %d", created.Version)
+	}
+
+	for want := uint(2); want <= 3; want++ {
+		var issue *models.Issue
+		store.Update(func(tx Tx) error {
+			issue, _ = tx.Issues().Get(created.ID)
+			return tx.Issues().Update(issue)
+		})
+		if issue.Version != want {
+			t.Errorf("Expected version %d after update, got %d", want, issue.Version)
+		}
+	}
+
+	// A stale copy is still stored one version after the current one
+	created.Title = "stale"
+	store.Update(func(tx Tx) error {
+		return tx.Issues().Update(created)
+	})
+	if created.Version != 4 {
+		t.Errorf("Expected version 4, got %d", created.Version)
+	}
+}
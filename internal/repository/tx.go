@@ -0,0 +1,482 @@
+package repository
+
+import "aoroa/internal/models"
+
+// tx is the transaction implementation shared by all stores
+type tx struct {
+	data     *dataset
+	writable bool
+	ops      []op
+	undo     []func()
+}
+
+func newTx(data *dataset, writable bool) *tx {
+	return &tx{data: data, writable: writable}
+}
+
+// Issues returns the issue repository bound to this transaction
+func (t *tx) Issues() IssueRepository {
+	return issueRepository{tx: t}
+}
+
+// Users returns the user repository bound to this transaction
+func (t *tx) Users() UserRepository {
+	return userRepository{tx: t}
+}
+
+// Comments returns the comment repository bound to this transaction
+func (t *tx) Comments() CommentRepository {
+	return commentRepository{tx: t}
+}
+
+// History returns the issue history repository bound to this transaction
+func (t *tx) History() HistoryRepository {
+	return historyRepository{tx: t}
+}
+
+// Changes returns the changes feed repository bound to this transaction
+func (t *tx) Changes() ChangeRepository {
+	return changeRepository{tx: t}
+}
+
+// Projects returns the project repository bound to this transaction
+func (t *tx) Projects() ProjectRepository {
+	return projectRepository{tx: t}
+}
+
+// Labels returns the label repository bound to this transaction
+func (t *tx) Labels() LabelRepository {
+	return labelRepository{tx: t}
+}
+
+// Links returns the issue link repository bound to this transaction
+func (t *tx) Links() LinkRepository {
+	return linkRepository{tx: t}
+}
+
+// Webhooks returns the webhook repository bound to this transaction
+func (t *tx) Webhooks() WebhookRepository {
+	return webhookRepository{tx: t}
+}
+
+// Deliveries returns the webhook delivery repository bound to this transaction
+func (t *tx) Deliveries() DeliveryRepository {
+	return deliveryRepository{tx: t}
+}
+
+// record remembers a change so it can be rolled back or persisted
+func (t *tx) record(o op, undo func()) {
+	t.ops = append(t.ops, o)
+	t.undo = append(t.undo, undo)
+}
+
+// rollback reverts every change made in this transaction
+func (t *tx) rollback() {
+	for i := len(t.undo) - 1; i >= 0; i-- {
+		t.undo[i]()
+	}
+	t.ops = nil
+	t.undo = nil
+}
+
+// reserveID validates id for a new record, allocating the next one when id is zero
+func reserveID[T any](t *tx, tbl *table[T], id uint) (uint, error) {
+	if !t.writable {
+		return 0, ErrReadOnly
+	}
+	if id == 0 {
+		id = tbl.allocate()
+	} else if _, exists := tbl.Rows[id]; exists {
+		return 0, ErrAlreadyExists
+	}
+	return id, nil
+}
+
+// write stores row under an existing or freshly allocated id
+func write[T any](t *tx, tbl *table[T], name string, id uint, row *T) {
+	undo := tbl.put(id, row)
+	stored := *tbl.Rows[id]
+	t.record(op{Table: name, ID: id, value: &stored}, undo)
+}
+
+// update replaces an existing record
+func update[T any](t *tx, tbl *table[T], name string, id uint, row *T) error {
+	if !t.writable {
+		return ErrReadOnly
+	}
+	if _, exists := tbl.Rows[id]; !exists {
+		return ErrNotFound
+	}
+	write(t, tbl, name, id, row)
+	return nil
+}
+
+// remove deletes an existing record
+func remove[T any](t *tx, tbl *table[T], name string, id uint) error {
+	if !t.writable {
+		return ErrReadOnly
+	}
+	if _, exists := tbl.Rows[id]; !exists {
+		return ErrNotFound
+	}
+	undo := tbl.remove(id)
+	t.record(op{Table: name, ID: id, Delete: true}, undo)
+	return nil
+}
+
+// issueRepository implements IssueRepository on top of a transaction
+type issueRepository struct {
+	tx *tx
+}
+
+func (r issueRepository) Get(id uint) (*models.Issue, error) {
+	issue, exists := r.tx.data.Issues.get(id)
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return issue, nil
+}
+
+func (r issueRepository) List() ([]*models.Issue, error) {
+	return r.tx.data.Issues.list(), nil
+}
+
+func (r issueRepository) Create(issue *models.Issue) error {
+	id, err := reserveID(r.tx, r.tx.data.Issues, issue.ID)
+	if err != nil {
+		return err
+	}
+	issue.ID = id
+	issue.Version = 1
+	write(r.tx, r.tx.data.Issues, tableIssues, id, issue)
+	return nil
+}
+
+func (r issueRepository) Update(issue *models.Issue) error {
+	if stored, exists := r.tx.data.Issues.Rows[issue.ID]; exists && r.tx.writable {
+		issue.Version = stored.Version + 1
+	}
+	return update(r.tx, r.tx.data.Issues, tableIssues, issue.ID, issue)
+}
+
+// userRepository implements UserRepository on top of a transaction
+type userRepository struct {
+	tx *tx
+}
+
+func (r userRepository) Get(id uint) (*models.User, error) {
+	user, exists := r.tx.data.Users.get(id)
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return user, nil
+}
+
+func (r userRepository) List() ([]*models.User, error) {
+	return r.tx.data.Users.list(), nil
+}
+
+func (r userRepository) Create(user *models.User) error {
+	id, err := reserveID(r.tx, r.tx.data.Users, user.ID)
+	if err != nil {
+		return err
+	}
+	user.ID = id
+	write(r.tx, r.tx.data.Users, tableUsers, id, user)
+	return nil
+}
+
+func (r userRepository) Update(user *models.User) error {
+	return update(r.tx, r.tx.data.Users, tableUsers, user.ID, user)
+}
+
+// commentRepository implements CommentRepository on top of a transaction
+type commentRepository struct {
+	tx *tx
+}
+
+func (r commentRepository) Get(id uint) (*models.Comment, error) {
+	comment, exists := r.tx.data.Comments.get(id)
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return comment, nil
+}
+
+func (r commentRepository) ListByIssue(issueID uint) ([]*models.Comment, error) {
+	var result []*models.Comment
+	for _, comment := range r.tx.data.Comments.list() {
+		if comment.IssueID == issueID {
+			result = append(result, comment)
+		}
+	}
+	return result, nil
+}
+
+func (r commentRepository) CountByIssue() (map[uint]int, error) {
+	counts := make(map[uint]int)
+	for _, comment := range r.tx.data.Comments.Rows {
+		counts[comment.IssueID]++
+	}
+	return counts, nil
+}
+
+func (r commentRepository) Create(comment *models.Comment) error {
+	id, err := reserveID(r.tx, r.tx.data.Comments, comment.ID)
+	if err != nil {
+		return err
+	}
+	comment.ID = id
+	write(r.tx, r.tx.data.Comments, tableComments, id, comment)
+	return nil
+}
+
+func (r commentRepository) Update(comment *models.Comment) error {
+	return update(r.tx, r.tx.data.Comments, tableComments, comment.ID, comment)
+}
+
+func (r commentRepository) Delete(id uint) error {
+	return remove(r.tx, r.tx.data.Comments, tableComments, id)
+}
+
+// historyRepository implements HistoryRepository on top of a transaction
+type historyRepository struct {
+	tx *tx
+}
+
+func (r historyRepository) Append(entry *models.HistoryEntry) error {
+	id, err := reserveID(r.tx, r.tx.data.History, 0)
+	if err != nil {
+		return err
+	}
+	entry.ID = id
+	write(r.tx, r.tx.data.History, tableHistory, id, entry)
+	return nil
+}
+
+func (r historyRepository) ListByIssue(issueID uint) ([]*models.HistoryEntry, error) {
+	var result []*models.HistoryEntry
+	for _, entry := range r.tx.data.History.list() {
+		if entry.IssueID == issueID {
+			result = append(result, entry)
+		}
+	}
+	return result, nil
+}
+
+// changeRepository implements ChangeRepository on top of a transaction
+type changeRepository struct {
+	tx *tx
+}
+
+func (r changeRepository) Append(change *models.Change) error {
+	seq, err := reserveID(r.tx, r.tx.data.Changes, 0)
+	if err != nil {
+		return err
+	}
+	change.Seq = seq
+	write(r.tx, r.tx.data.Changes, tableChanges, seq, change)
+	return nil
+}
+
+func (r changeRepository) ListSince(since uint, limit int) ([]*models.Change, error) {
+	// Sequence numbers are dense because changes are never deleted
+	var result []*models.Change
+	for seq := since + 1; seq < r.tx.data.Changes.NextID && len(result) < limit; seq++ {
+		if change, exists := r.tx.data.Changes.get(seq); exists {
+			result = append(result, change)
+		}
+	}
+	return result, nil
+}
+
+func (r changeRepository) LastSeq() (uint, error) {
+	return r.tx.data.Changes.NextID - 1, nil
+}
+
+// projectRepository implements ProjectRepository on top of a transaction
+type projectRepository struct {
+	tx *tx
+}
+
+func (r projectRepository) Get(id uint) (*models.Project, error) {
+	project, exists := r.tx.data.Projects.get(id)
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return project, nil
+}
+
+func (r projectRepository) List() ([]*models.Project, error) {
+	return r.tx.data.Projects.list(), nil
+}
+
+func (r projectRepository) Create(project *models.Project) error {
+	id, err := reserveID(r.tx, r.tx.data.Projects, project.ID)
+	if err != nil {
+		return err
+	}
+	project.ID = id
+	write(r.tx, r.tx.data.Projects, tableProjects, id, project)
+	return nil
+}
+
+func (r projectRepository) Update(project *models.Project) error {
+	return update(r.tx, r.tx.data.Projects, tableProjects, project.ID, project)
+}
+
+// labelRepository implements LabelRepository on top of a transaction
+type labelRepository struct {
+	tx *tx
+}
+
+func (r labelRepository) Get(id uint) (*models.Label, error) {
+	label, exists := r.tx.data.Labels.get(id)
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return label, nil
+}
+
+func (r labelRepository) List() ([]*models.Label, error) {
+	return r.tx.data.Labels.list(), nil
+}
+
+func (r labelRepository) Create(label *models.Label) error {
+	id, err := reserveID(r.tx, r.tx.data.Labels, label.ID)
+	if err != nil {
+		return err
+	}
+	label.ID = id
+	write(r.tx, r.tx.data.Labels, tableLabels, id, label)
+	return nil
+}
+
+func (r labelRepository) Update(label *models.Label) error {
+	return update(r.tx, r.tx.data.Labels, tableLabels, label.ID, label)
+}
+
+func (r labelRepository) Delete(id uint) error {
+	return remove(r.tx, r.tx.data.Labels, tableLabels, id)
+}
+
+// linkRepository implements LinkRepository on top of a transaction
+type linkRepository struct {
+	tx *tx
+}
+
+func (r linkRepository) Get(id uint) (*models.IssueLink, error) {
+	link, exists := r.tx.data.Links.get(id)
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return link, nil
+}
+
+func (r linkRepository) List() ([]*models.IssueLink, error) {
+	return r.tx.data.Links.list(), nil
+}
+
+func (r linkRepository) ListByIssue(issueID uint) ([]*models.IssueLink, error) {
+	var result []*models.IssueLink
+	for _, link := range r.tx.data.Links.list() {
+		if link.SourceID == issueID || link.TargetID == issueID {
+			result = append(result, link)
+		}
+	}
+	return result, nil
+}
+
+func (r linkRepository) Create(link *models.IssueLink) error {
+	id, err := reserveID(r.tx, r.tx.data.Links, link.ID)
+	if err != nil {
+		return err
+	}
+	link.ID = id
+	write(r.tx, r.tx.data.Links, tableLinks, id, link)
+	return nil
+}
+
+func (r linkRepository) Delete(id uint) error {
+	return remove(r.tx, r.tx.data.Links, tableLinks, id)
+}
+
+// webhookRepository implements WebhookRepository on top of a transaction
+type webhookRepository struct {
+	tx *tx
+}
+
+func (r webhookRepository) Get(id uint) (*models.Webhook, error) {
+	webhook, exists := r.tx.data.Webhooks.get(id)
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return webhook, nil
+}
+
+func (r webhookRepository) List() ([]*models.Webhook, error) {
+	return r.tx.data.Webhooks.list(), nil
+}
+
+func (r webhookRepository) Create(webhook *models.Webhook) error {
+	id, err := reserveID(r.tx, r.tx.data.Webhooks, webhook.ID)
+	if err != nil {
+		return err
+	}
+	webhook.ID = id
+	write(r.tx, r.tx.data.Webhooks, tableWebhooks, id, webhook)
+	return nil
+}
+
+func (r webhookRepository) Update(webhook *models.Webhook) error {
+	return update(r.tx, r.tx.data.Webhooks, tableWebhooks, webhook.ID, webhook)
+}
+
+func (r webhookRepository) Delete(id uint) error {
+	return remove(r.tx, r.tx.data.Webhooks, tableWebhooks, id)
+}
+
+// deliveryRepository implements DeliveryRepository on top of a transaction
+type deliveryRepository struct {
+	tx *tx
+}
+
+func (r deliveryRepository) Get(id uint) (*models.WebhookDelivery, error) {
+	delivery, exists := r.tx.data.Deliveries.get(id)
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return delivery, nil
+}
+
+func (r deliveryRepository) List() ([]*models.WebhookDelivery, error) {
+	return r.tx.data.Deliveries.list(), nil
+}
+
+func (r deliveryRepository) ListByWebhook(webhookID uint) ([]*models.WebhookDelivery, error) {
+	var result []*models.WebhookDelivery
+	for _, delivery := range r.tx.data.Deliveries.list() {
+		if delivery.WebhookID == webhookID {
+			result = append(result, delivery)
+		}
+	}
+	return result, nil
+}
+
+func (r deliveryRepository) Create(delivery *models.WebhookDelivery) error {
+	id, err := reserveID(r.tx, r.tx.data.Deliveries, delivery.ID)
+	if err != nil {
+		return err
+	}
+	delivery.ID = id
+	write(r.tx, r.tx.data.Deliveries, tableDeliveries, id, delivery)
+	return nil
+}
+
+func (r deliveryRepository) Update(delivery *models.WebhookDelivery) error {
+	return update(r.tx, r.tx.data.Deliveries, tableDeliveries, delivery.ID, delivery)
+}
+
+func (r deliveryRepository) Delete(id uint) error {
+	return remove(r.tx, r.tx.data.Deliveries, tableDeliveries, id)
+}
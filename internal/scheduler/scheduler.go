@@ -0,0 +1,109 @@
+// Package scheduler runs periodic background jobs inside the server process.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+// ErrAlreadyStarted is returned when starting a scheduler that is running
+var ErrAlreadyStarted = errors.New("scheduler already started")
+
+// JobFunc is the work of a job. ctx is cancelled when the scheduler stops;
+// now is the time of the tick that triggered the run.
+type JobFunc func(ctx context.Context, now time.Time) error
+
+// job is a named task run at a fixed interval
+type job struct {
+	name     string
+	interval time.Duration
+	run      JobFunc
+}
+
+// Scheduler runs jobs at fixed intervals. Each job runs in its own goroutine,
+// so a slow job delays only its own next run, never another job's.
+type Scheduler struct {
+	mu     sync.Mutex
+	jobs   []job
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a scheduler without jobs
+func New() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers a job run every interval once the scheduler starts.
+// Jobs registered after Start run from the next start on.
+func (s *Scheduler) Every(name string, interval time.Duration, run JobFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs = append(s.jobs, job{name: name, interval: interval, run: run})
+}
+
+// Start launches every registered job
+func (s *Scheduler) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancel != nil {
+		return ErrAlreadyStarted
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(ctx, j)
+	}
+	return nil
+}
+
+// Stop cancels the running jobs and waits for them to return, or until ctx is done
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.cancel = nil
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// loop runs a job on every tick until ctx is cancelled
+func (s *Scheduler) loop(ctx context.Context, j job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := j.run(ctx, now); err != nil {
+				log.Printf("Scheduled job %s failed: %v", j.name, err)
+			}
+		}
+	}
+}
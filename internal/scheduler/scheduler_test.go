@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSchedulerRunsJobsUntilStopped(t *testing.T) {
+	s := New()
+	var runs atomic.Int32
+	ran := make(chan struct{}, 10)
+	s.Every("count", 5*time.Millisecond, func(ctx context.Context, now time.Time) error {
+		runs.Add(1)
+		ran <- struct{}{}
+		return errors.New("failures are logged and do not stop the job")
+	})
+
+	if err := s.Start(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := s.Start(); !errors.Is(err, ErrAlreadyStarted) {
+		t.Errorf("Expected already started error, got %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-ran:
+		case <-time.After(time.Second):
+			t.Fatal("Job did not run")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := s.Stop(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	stopped := runs.Load()
+	time.Sleep(20 * time.Millisecond)
+	if runs.Load() != stopped {
+		t.Error("Expected no runs after Stop")
+	}
+}
+
+func TestSchedulerStopWaitsForRunningJob(t *testing.T) {
+	s := New()
+	started := make(chan struct{})
+	s.Every("slow", time.Millisecond, func(ctx context.Context, now time.Time) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+	s.Start()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if err := s.Stop(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected stop to time out while the job finishes, got %v", err)
+	}
+}
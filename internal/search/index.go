@@ -0,0 +1,237 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// positionGap separates multiple values of one field so phrases never span them
+const positionGap = 100
+
+// Document is the searchable content of one item, keyed by field name.
+// A field may hold several values, such as the bodies of all comments.
+type Document map[string][]string
+
+// Result is a matching document and its relevance score
+type Result struct {
+	ID    uint
+	Score float64
+}
+
+// posting holds the positions of one term in one document, per field
+type posting map[string][]int
+
+// Index is an in-memory inverted index with positional postings.
+// It is safe for concurrent use.
+type Index struct {
+	mu       sync.RWMutex
+	weights  map[string]float64
+	postings map[string]map[uint]posting // term -> document -> field positions
+	terms    map[uint][]string           // document -> indexed terms, used for removal
+}
+
+// NewIndex creates an empty index. weights gives the relevance weight of each
+// field; fields without a weight count as 1.
+func NewIndex(weights map[string]float64) *Index {
+	return &Index{
+		weights:  weights,
+		postings: make(map[string]map[uint]posting),
+		terms:    make(map[uint][]string),
+	}
+}
+
+// Put indexes a document, replacing any previous version with the same ID
+func (idx *Index) Put(id uint, doc Document) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(id)
+
+	seen := make(map[string]bool)
+	for field, values := range doc {
+		offset := 0
+		for _, value := range values {
+			tokens := Tokenize(value)
+			for _, token := range tokens {
+				docs, exists := idx.postings[token.Term]
+				if !exists {
+					docs = make(map[uint]posting)
+					idx.postings[token.Term] = docs
+				}
+				p, exists := docs[id]
+				if !exists {
+					p = make(posting)
+					docs[id] = p
+				}
+				p[field] = append(p[field], offset+token.Pos)
+
+				if !seen[token.Term] {
+					seen[token.Term] = true
+					idx.terms[id] = append(idx.terms[id], token.Term)
+				}
+			}
+			if len(tokens) > 0 {
+				offset += tokens[len(tokens)-1].Pos + positionGap
+			}
+		}
+	}
+}
+
+// Remove deletes a document from the index
+func (idx *Index) Remove(id uint) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(id)
+}
+
+// Len returns the number of indexed documents
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return len(idx.terms)
+}
+
+// Search returns documents matching every clause of the query, best first.
+// Words are matched individually and "quoted text" is matched as a phrase.
+func (idx *Index) Search(query string) []Result {
+	clauses := ParseQuery(query)
+	if len(clauses) == 0 {
+		return nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	scores := make(map[uint]float64)
+	for i, clause := range clauses {
+		matches := idx.matchClause(clause)
+		if len(matches) == 0 {
+			return nil
+		}
+
+		idf := math.Log(1 + float64(len(idx.terms))/float64(len(matches)))
+		next := make(map[uint]float64)
+		for id, fieldCounts := range matches {
+			if _, ok := scores[id]; i > 0 && !ok {
+				continue
+			}
+			score := scores[id]
+			for field, count := range fieldCounts {
+				score += idx.weight(field) * (1 + math.Log(float64(count))) * idf
+			}
+			next[id] = score
+		}
+		scores = next
+		if len(scores) == 0 {
+			return nil
+		}
+	}
+
+	results := make([]Result, 0, len(scores))
+	for id, score := range scores {
+		results = append(results, Result{ID: id, Score: score})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].ID < results[j].ID
+	})
+	return results
+}
+
+// matchClause returns, for each matching document, the number of occurrences per field
+func (idx *Index) matchClause(terms []string) map[uint]map[string]int {
+	first, exists := idx.postings[terms[0]]
+	if !exists {
+		return nil
+	}
+
+	matches := make(map[uint]map[string]int)
+	for id, p := range first {
+		for field, positions := range p {
+			count := 0
+			for _, start := range positions {
+				if idx.phraseAt(terms[1:], id, field, start+1) {
+					count++
+				}
+			}
+			if count > 0 {
+				if matches[id] == nil {
+					matches[id] = make(map[string]int)
+				}
+				matches[id][field] = count
+			}
+		}
+	}
+	return matches
+}
+
+// phraseAt reports whether terms occur consecutively in a field starting at pos
+func (idx *Index) phraseAt(terms []string, id uint, field string, pos int) bool {
+	for i, term := range terms {
+		p, exists := idx.postings[term][id]
+		if !exists || !containsPosition(p[field], pos+i) {
+			return false
+		}
+	}
+	return true
+}
+
+func (idx *Index) removeLocked(id uint) {
+	for _, term := range idx.terms[id] {
+		delete(idx.postings[term], id)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	delete(idx.terms, id)
+}
+
+func (idx *Index) weight(field string) float64 {
+	if weight, exists := idx.weights[field]; exists {
+		return weight
+	}
+	return 1
+}
+
+// containsPosition searches the ascending positions for pos
+func containsPosition(positions []int, pos int) bool {
+	i := sort.SearchInts(positions, pos)
+	return i < len(positions) && positions[i] == pos
+}
+
+// ParseQuery splits a query into clauses of consecutive terms. Quoted text
+// forms one phrase clause; every other word is its own clause, which is itself
+// a phrase when the word tokenizes into several terms (e.g. Hangul bigrams).
+func ParseQuery(query string) [][]string {
+	var clauses [][]string
+
+	add := func(text string) {
+		tokens := Tokenize(text)
+		if len(tokens) == 0 {
+			return
+		}
+		terms := make([]string, len(tokens))
+		for i, token := range tokens {
+			terms[i] = token.Term
+		}
+		clauses = append(clauses, terms)
+	}
+
+	for i, part := range strings.Split(query, `"`) {
+		if i%2 == 1 {
+			add(part)
+			continue
+		}
+		for _, word := range strings.Fields(part) {
+			add(word)
+		}
+	}
+
+	return clauses
+}
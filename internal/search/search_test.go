@@ -0,0 +1,118 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func terms(tokens []Token) []string {
+	result := make([]string, len(tokens))
+	for i, token := range tokens {
+		result[i] = token.Term
+	}
+	return result
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected []string
+	}{
+		{"English words", "Fix the Login-Page crash!", []string{"fix", "the", "login", "page", "crash"}},
+		{"Hangul bigrams", "로그인 오류", []string{"로그", "그인", "오류"}},
+		{"Single Hangul syllable", "꽃", []string{"꽃"}},
+		{"Mixed scripts split", "API를 호출", []string{"api", "를", "호출"}},
+		{"Digits", "v2 release 2025", []string{"v2", "release", "2025"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := terms(Tokenize(tt.text))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Tokenize(%q) = %v, want %v", tt.text, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	got := ParseQuery(`login "page crash" 로그인`)
+	expected := [][]string{{"login"}, {"page", "crash"}, {"로그", "그인"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("ParseQuery() = %v, want %v", got, expected)
+	}
+}
+
+func newTestIndex() *Index {
+	idx := NewIndex(map[string]float64{"title": 3, "body": 1})
+	idx.Put(1, Document{"title": {"Login page crash"}, "body": {"The app crashes after login"}})
+	idx.Put(2, Document{"title": {"Dashboard"}, "body": {"login page is slow", "crash report attached"}})
+	idx.Put(3, Document{"title": {"로그인 오류"}, "body": {"로그인에서 오류가 발생합니다"}})
+	return idx
+}
+
+func resultIDs(results []Result) []uint {
+	ids := make([]uint, len(results))
+	for i, result := range results {
+		ids[i] = result.ID
+	}
+	return ids
+}
+
+func TestIndexSearchRanksTitleMatchesHigher(t *testing.T) {
+	got := resultIDs(newTestIndex().Search("login"))
+	expected := []uint{1, 2}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Search(login) = %v, want %v", got, expected)
+	}
+}
+
+func TestIndexSearchRequiresAllClauses(t *testing.T) {
+	if got := resultIDs(newTestIndex().Search("login dashboard")); !reflect.DeepEqual(got, []uint{2}) {
+		t.Errorf("Expected only issue 2, got %v", got)
+	}
+	if got := newTestIndex().Search("login missing"); len(got) != 0 {
+		t.Errorf("Expected no results, got %v", resultIDs(got))
+	}
+}
+
+func TestIndexSearchPhrase(t *testing.T) {
+	idx := newTestIndex()
+
+	if got := resultIDs(idx.Search(`"page crash"`)); !reflect.DeepEqual(got, []uint{1}) {
+		t.Errorf("Expected phrase to match only issue 1, got %v", got)
+	}
+	// Phrases never span separate values of a field
+	if got := idx.Search(`"slow crash"`); len(got) != 0 {
+		t.Errorf("Expected no match across values, got %v", resultIDs(got))
+	}
+}
+
+func TestIndexSearchKorean(t *testing.T) {
+	idx := newTestIndex()
+
+	if got := resultIDs(idx.Search("로그인")); !reflect.DeepEqual(got, []uint{3}) {
+		t.Errorf("Expected 로그인 to match issue 3, got %v", got)
+	}
+	if got := resultIDs(idx.Search("발생")); !reflect.DeepEqual(got, []uint{3}) {
+		t.Errorf("Expected 발생 to match inside 발생합니다, got %v", got)
+	}
+}
+
+func TestIndexPutReplacesAndRemove(t *testing.T) {
+	idx := newTestIndex()
+
+	idx.Put(1, Document{"title": {"Renamed"}})
+	if got := resultIDs(idx.Search("crash")); !reflect.DeepEqual(got, []uint{2}) {
+		t.Errorf("Expected old terms of issue 1 to be gone, got %v", got)
+	}
+
+	idx.Remove(2)
+	if got := idx.Search("crash"); len(got) != 0 {
+		t.Errorf("Expected no results after removal, got %v", resultIDs(got))
+	}
+	if idx.Len() != 2 {
+		t.Errorf("Expected 2 documents, got %d", idx.Len())
+	}
+}
@@ -0,0 +1,75 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Token is a normalized term and its position within a field
+type Token struct {
+	Term string
+	Pos  int
+}
+
+// Tokenize splits text into lowercase terms with positions.
+//
+// Latin and other scripts are split into words on any character that is not a
+// letter or digit. Hangul words are split into overlapping character bigrams
+// (로그인 → 로그, 그인) so that a word matches regardless of the particles and
+// endings attached to it (로그인에서, 로그인을). Because bigrams of one word have
+// consecutive positions, a multi-bigram query word is matched as a phrase.
+func Tokenize(text string) []Token {
+	var tokens []Token
+	pos := 0
+
+	for _, word := range splitWords(text) {
+		if isHangulWord(word) && len(word) > 1 {
+			for i := 0; i+1 < len(word); i++ {
+				tokens = append(tokens, Token{Term: string(word[i : i+2]), Pos: pos})
+				pos++
+			}
+			continue
+		}
+
+		tokens = append(tokens, Token{Term: strings.ToLower(string(word)), Pos: pos})
+		pos++
+	}
+
+	return tokens
+}
+
+// splitWords splits text into runs of letters and digits. A change between
+// Hangul and other scripts also ends a word, so "API를" yields "API" and "를".
+func splitWords(text string) [][]rune {
+	var words [][]rune
+	var current []rune
+
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, current)
+			current = nil
+		}
+	}
+
+	for _, r := range text {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if len(current) > 0 && isHangul(current[len(current)-1]) != isHangul(r) {
+			flush()
+		}
+		current = append(current, r)
+	}
+	flush()
+
+	return words
+}
+
+func isHangulWord(word []rune) bool {
+	return len(word) > 0 && isHangul(word[0])
+}
+
+func isHangul(r rune) bool {
+	return unicode.Is(unicode.Hangul, r)
+}
@@ -1,40 +1,175 @@
 package server
 
 import (
+	"net/http"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
 	"aoroa/internal/handler"
+	"aoroa/internal/repository"
 	"aoroa/internal/service"
+	"aoroa/internal/workflow"
 	serverPkg "aoroa/pkg/server"
-
-	"github.com/gin-gonic/gin"
 )
 
-// IssueHandlerRegistrar는 이슈 관련 라우트를 등록하는 구조체입니다
+// apiV1Prefix는 현재 API 버전의 경로 접두사입니다
+const apiV1Prefix = "/api/v1"
+
+// legacyRoutesDeprecatedAt은 접두사 없는 경로가 폐기 예정으로 지정된 시점입니다
+var legacyRoutesDeprecatedAt = time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)
+
+// IssueHandlerRegistrar는 이슈, 댓글 및 사용자 관련 라우트를 등록하는 구조체입니다
 type IssueHandlerRegistrar struct {
-	userService  *service.UserService
-	issueService *service.IssueService
+	userService    *service.UserService
+	issueService   *service.IssueService
+	commentService *service.CommentService
+	projectService *service.ProjectService
+	labelService   *service.LabelService
+	webhookService *service.WebhookService
+	issueStream    *service.IssueStream
+	overdueMonitor *service.OverdueMonitor
 }
 
-// NewIssueHandlerRegistrar는 새로운 핸들러 등록자를 생성합니다
-func NewIssueHandlerRegistrar() *IssueHandlerRegistrar {
-	userService := service.NewUserService()
-	issueService := service.NewIssueService(userService)
+// NewIssueHandlerRegistrar는 주어진 저장소와 워크플로를 사용하는 새로운 핸들러 등록자를 생성합니다.
+// 저장소에 사용자가 없으면 seedUsers로 초기 사용자를 생성하고, 웹훅은 webhookOpts에 따라 전송합니다
+func NewIssueHandlerRegistrar(store repository.Store, wf *workflow.Workflow, seedUsers []domain.CreateUserRequest, webhookOpts service.WebhookOptions) (*IssueHandlerRegistrar, error) {
+	userService, err := service.NewUserServiceWithSeeds(store, seedUsers)
+	if err != nil {
+		return nil, err
+	}
+	issueService := service.NewIssueServiceWithWorkflow(userService, wf)
+	commentService := service.NewCommentService(issueService)
+	projectService := service.NewProjectService(userService)
+	labelService := service.NewLabelService(userService)
+	webhookService := service.NewWebhookService(userService, webhookOpts)
+	issueStream := service.NewIssueStream(issueService, service.DefaultStreamReplaySize)
+
+	// 기한 초과 이벤트는 도메인 버스로 발행되어 웹훅과 이슈 스트림에 전달되며, 로그에도 남깁니다
+	overdueMonitor := service.NewOverdueMonitor(issueService)
+	userService.Events().Subscribe(events.On(service.LogOverdue))
 
 	return &IssueHandlerRegistrar{
-		userService:  userService,
-		issueService: issueService,
-	}
+		userService:    userService,
+		issueService:   issueService,
+		commentService: commentService,
+		projectService: projectService,
+		labelService:   labelService,
+		webhookService: webhookService,
+		issueStream:    issueStream,
+		overdueMonitor: overdueMonitor,
+	}, nil
 }
 
-// RegisterRoutes는 이슈 관련 라우트들을 등록합니다
+// RegisterRoutes는 이슈, 댓글, 사용자, 프로젝트 및 라벨 관련 라우트들을 /api/v1 아래에 등록합니다.
+// 접두사 없는 기존 경로는 Deprecation 헤더를 보내는 별칭으로 유지됩니다 - /api/v1 도입 이후 추가된 라우트는 별칭이 없습니다
 func (r *IssueHandlerRegistrar) RegisterRoutes(framework serverPkg.WebFramework) error {
-	// Gin 핸들러 래퍼 생성
-	ginHandler := handler.NewGinIssueHandler(r.issueService)
+	routes := r.routes()
+
+	api := framework.Group("/api")
+	v1 := api.Group("/v1")
+	serverPkg.Mount(v1, routes)
+	serverPkg.Mount(v1, r.issueRoutes())
+	serverPkg.Mount(v1, r.projectRoutes())
+	serverPkg.Mount(v1, r.labelRoutes())
+	serverPkg.Mount(v1, r.webhookRoutes())
 
-	// API 라우트 등록 - 메서드를 함수로 변환
-	framework.POST("/issue", gin.HandlerFunc(ginHandler.CreateIssue))
-	framework.GET("/issues", gin.HandlerFunc(ginHandler.GetIssues))
-	framework.GET("/issue/:id", gin.HandlerFunc(ginHandler.GetIssue))
-	framework.PUT("/issue/:id", gin.HandlerFunc(ginHandler.UpdateIssue))
+	legacy := framework.Group("", serverPkg.Deprecated(legacyRoutesDeprecatedAt, apiV1Prefix))
+	serverPkg.Mount(legacy, routes)
 
 	return nil
 }
+
+// routes는 API 라우트 목록을 반환합니다 - 핸들러 메서드는 프레임워크 중립 HandlerFunc로 전달됩니다
+func (r *IssueHandlerRegistrar) routes() []serverPkg.Route {
+	issueHandler := handler.NewIssueHandler(r.issueService)
+	commentHandler := handler.NewCommentHandler(r.commentService)
+	userHandler := handler.NewUserHandler(r.userService)
+
+	return []serverPkg.Route{
+		// 이슈 라우트
+		{Method: http.MethodPost, Path: "/issue", Handler: issueHandler.CreateIssue},
+		{Method: http.MethodGet, Path: "/issues", Handler: issueHandler.GetIssues},
+		{Method: http.MethodGet, Path: "/issues/search", Handler: issueHandler.SearchIssues},
+		{Method: http.MethodGet, Path: "/issue/:id", Handler: issueHandler.GetIssue},
+		{Method: http.MethodPut, Path: "/issue/:id", Handler: issueHandler.UpdateIssue},
+		{Method: http.MethodPatch, Path: "/issue/:id", Handler: issueHandler.UpdateIssue},
+		{Method: http.MethodGet, Path: "/issue/:id/history", Handler: issueHandler.GetIssueHistory},
+
+		// 댓글 라우트
+		{Method: http.MethodPost, Path: "/issue/:id/comments", Handler: commentHandler.CreateComment},
+		{Method: http.MethodGet, Path: "/issue/:id/comments", Handler: commentHandler.GetComments},
+		{Method: http.MethodPatch, Path: "/issue/:id/comments/:commentId", Handler: commentHandler.UpdateComment},
+		{Method: http.MethodDelete, Path: "/issue/:id/comments/:commentId", Handler: commentHandler.DeleteComment},
+
+		// 사용자 라우트
+		{Method: http.MethodPost, Path: "/users", Handler: userHandler.CreateUser},
+		{Method: http.MethodGet, Path: "/users", Handler: userHandler.GetUsers},
+		{Method: http.MethodGet, Path: "/users/:id", Handler: userHandler.GetUser},
+		{Method: http.MethodPatch, Path: "/users/:id", Handler: userHandler.UpdateUser},
+		{Method: http.MethodPost, Path: "/users/:id/deactivate", Handler: userHandler.DeactivateUser},
+	}
+}
+
+// issueRoutes는 /api/v1 아래에만 등록되는 이슈 라우트 목록을 반환합니다
+func (r *IssueHandlerRegistrar) issueRoutes() []serverPkg.Route {
+	issueHandler := handler.NewIssueHandler(r.issueService)
+	issueStreamHandler := handler.NewIssueStreamHandler(r.issueStream)
+
+	return []serverPkg.Route{
+		{Method: http.MethodGet, Path: "/issues/stream", Handler: issueStreamHandler.StreamIssues},
+		{Method: http.MethodGet, Path: "/issue/:id/children", Handler: issueHandler.GetIssueChildren},
+		{Method: http.MethodPost, Path: "/issue/:id/links", Handler: issueHandler.CreateIssueLink},
+		{Method: http.MethodGet, Path: "/issue/:id/links", Handler: issueHandler.GetIssueLinks},
+		{Method: http.MethodDelete, Path: "/issue/:id/links/:linkId", Handler: issueHandler.DeleteIssueLink},
+
+		// 변경 피드 라우트 - 증분 동기화용
+		{Method: http.MethodGet, Path: "/changes", Handler: issueHandler.GetChanges},
+	}
+}
+
+// projectRoutes는 /api/v1 아래에만 등록되는 프로젝트 라우트 목록을 반환합니다
+func (r *IssueHandlerRegistrar) projectRoutes() []serverPkg.Route {
+	projectHandler := handler.NewProjectHandler(r.projectService, r.issueService)
+
+	return []serverPkg.Route{
+		{Method: http.MethodPost, Path: "/projects", Handler: projectHandler.CreateProject},
+		{Method: http.MethodGet, Path: "/projects", Handler: projectHandler.GetProjects},
+		{Method: http.MethodGet, Path: "/projects/:key", Handler: projectHandler.GetProject},
+		{Method: http.MethodPatch, Path: "/projects/:key", Handler: projectHandler.UpdateProject},
+		{Method: http.MethodPost, Path: "/projects/:key/members", Handler: projectHandler.AddMember},
+		{Method: http.MethodDelete, Path: "/projects/:key/members/:userId", Handler: projectHandler.RemoveMember},
+
+		// 프로젝트 이슈 라우트 - 프로젝트 멤버 또는 관리자만 접근할 수 있습니다
+		{Method: http.MethodPost, Path: "/projects/:key/issues", Handler: projectHandler.CreateProjectIssue},
+		{Method: http.MethodGet, Path: "/projects/:key/issues", Handler: projectHandler.GetProjectIssues},
+		{Method: http.MethodGet, Path: "/projects/:key/issues/:number", Handler: projectHandler.GetProjectIssue},
+	}
+}
+
+// labelRoutes는 /api/v1 아래에만 등록되는 라벨 라우트 목록을 반환합니다
+func (r *IssueHandlerRegistrar) labelRoutes() []serverPkg.Route {
+	labelHandler := handler.NewLabelHandler(r.labelService)
+
+	return []serverPkg.Route{
+		{Method: http.MethodPost, Path: "/labels", Handler: labelHandler.CreateLabel},
+		{Method: http.MethodGet, Path: "/labels", Handler: labelHandler.GetLabels},
+		{Method: http.MethodGet, Path: "/labels/:id", Handler: labelHandler.GetLabel},
+		{Method: http.MethodPatch, Path: "/labels/:id", Handler: labelHandler.UpdateLabel},
+		{Method: http.MethodDelete, Path: "/labels/:id", Handler: labelHandler.DeleteLabel},
+	}
+}
+
+// webhookRoutes는 웹훅 관리 및 전송 기록 라우트 목록을 반환합니다 (관리자 전용)
+func (r *IssueHandlerRegistrar) webhookRoutes() []serverPkg.Route {
+	webhookHandler := handler.NewWebhookHandler(r.webhookService)
+
+	return []serverPkg.Route{
+		{Method: http.MethodPost, Path: "/webhooks", Handler: webhookHandler.CreateWebhook},
+		{Method: http.MethodGet, Path: "/webhooks", Handler: webhookHandler.GetWebhooks},
+		{Method: http.MethodGet, Path: "/webhooks/:id", Handler: webhookHandler.GetWebhook},
+		{Method: http.MethodPatch, Path: "/webhooks/:id", Handler: webhookHandler.UpdateWebhook},
+		{Method: http.MethodDelete, Path: "/webhooks/:id", Handler: webhookHandler.DeleteWebhook},
+		{Method: http.MethodGet, Path: "/webhooks/:id/deliveries", Handler: webhookHandler.GetWebhookDeliveries},
+	}
+}
@@ -1,30 +1,114 @@
 package server
 
 import (
+	"context"
 	"log"
+	"time"
 
+	"aoroa/internal/config"
+	"aoroa/internal/domain"
+	"aoroa/internal/repository"
+	"aoroa/internal/scheduler"
+	"aoroa/internal/service"
+	"aoroa/internal/workflow"
 	serverPkg "aoroa/pkg/server"
+
+	"github.com/gin-gonic/gin"
 )
 
 // Server represents the HTTP server
 type Server struct {
 	abstractServer serverPkg.ServerInterface
+	store          repository.Store
+	addr           string
 }
 
-// New creates a new server instance
-func New() *Server {
-	// Gin 프레임워크 어댑터 생성
-	ginFramework := serverPkg.NewGinFrameworkAdapter()
+// New creates a new server instance from a validated configuration
+func New(cfg *config.Config) (*Server, error) {
+	// 워크플로 로드
+	wf := workflow.Default()
+	if cfg.Workflow != "" {
+		loaded, err := workflow.Load(cfg.Workflow)
+		if err != nil {
+			return nil, err
+		}
+		wf = loaded
+	}
+
+	// 디버그 로그 레벨이 아니면 Gin을 릴리스 모드로 실행
+	if cfg.IsDebug() {
+		gin.SetMode(gin.DebugMode)
+	} else {
+		gin.SetMode(gin.ReleaseMode)
+	}
+
+	// 웹 프레임워크 어댑터 생성
+	framework, err := serverPkg.NewWebFramework(cfg.Server.Framework)
+	if err != nil {
+		return nil, err
+	}
+
+	// 공통 미들웨어 등록 (요청 ID → 로깅 → 패닉 복구 순), 요청 로그는 info 이하 레벨에서만 기록
+	framework.Use(serverPkg.RequestID())
+	if cfg.LogsRequests() {
+		framework.Use(serverPkg.Logger())
+	}
+	framework.Use(serverPkg.Recovery())
+
+	// 저장소 생성
+	store, err := repository.Open(cfg.Storage.Backend, cfg.Storage.DataDir)
+	if err != nil {
+		return nil, err
+	}
 
 	// 핸들러 등록자 생성
-	handlerRegistrar := NewIssueHandlerRegistrar()
+	seedUsers := make([]domain.CreateUserRequest, len(cfg.SeedUsers))
+	for i, user := range cfg.SeedUsers {
+		seedUsers[i] = domain.CreateUserRequest{Name: user.Name, Email: user.Email, Role: user.Role}
+	}
+	webhookOpts := service.WebhookOptions{
+		MaxAttempts: cfg.Webhooks.MaxAttempts,
+		Timeout:     time.Duration(cfg.Webhooks.Timeout),
+		Backoff:     time.Duration(cfg.Webhooks.Backoff),
+	}
+	handlerRegistrar, err := NewIssueHandlerRegistrar(store, wf, seedUsers, webhookOpts)
+	if err != nil {
+		store.Close()
+		return nil, err
+	}
+
+	// 백그라운드 스케줄러 생성 - 서버와 함께 시작되고 종료됩니다
+	jobs := scheduler.New()
+	if interval := time.Duration(cfg.Scheduler.OverdueInterval); interval > 0 {
+		jobs.Every("overdue-issues", interval, func(ctx context.Context, now time.Time) error {
+			_, err := handlerRegistrar.overdueMonitor.Check(now)
+			return err
+		})
+	}
+	if interval := time.Duration(cfg.Scheduler.WebhookRetryInterval); interval > 0 {
+		jobs.Every("webhook-retries", interval, handlerRegistrar.webhookService.RetryDue)
+	}
+
+	// 웹훅 전송 워커 - 버스보다 나중에 멈추도록 먼저 등록합니다 (서비스는 역순으로 종료)
+	webhooks := handlerRegistrar.webhookService
+	// 도메인 이벤트 버스 - 종료 시 비동기 구독자에 남은 이벤트를 처리한 뒤 멈춥니다
+	bus := handlerRegistrar.userService.Events()
+	// 이슈 이벤트 스트림 - 종료 시 열린 SSE 연결을 끝내 셧다운이 기다리지 않게 합니다
+	issueStream := handlerRegistrar.issueStream
 
 	// 추상화된 서버 생성
-	abstractServer := serverPkg.NewAbstractServer(ginFramework, handlerRegistrar)
+	abstractServer := serverPkg.NewAbstractServerWithTimeouts(framework, handlerRegistrar, serverPkg.Timeouts{
+		Read:     time.Duration(cfg.Server.ReadTimeout),
+		Write:    time.Duration(cfg.Server.WriteTimeout),
+		Idle:     time.Duration(cfg.Server.IdleTimeout),
+		Shutdown: time.Duration(cfg.Server.ShutdownTimeout),
+	}, webhooks, bus, issueStream, jobs)
 
 	return &Server{
 		abstractServer: abstractServer,
-	}
+		store:          store,
+		addr:           cfg.Server.Addr,
+	}, nil
 }
 
 // Initialize sets up the server with all dependencies
@@ -38,7 +122,11 @@ func (s *Server) Initialize() {
 func (s *Server) Run() {
 	s.Initialize()
 
-	if err := s.abstractServer.Start(":8080"); err != nil {
+	if err := s.abstractServer.Start(s.addr); err != nil {
 		log.Printf("Server error: %v", err)
 	}
+
+	if err := s.store.Close(); err != nil {
+		log.Printf("Failed to close storage: %v", err)
+	}
 }
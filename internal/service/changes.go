@@ -0,0 +1,129 @@
+package service
+
+import (
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+// ChangesPage is one page of the changes feed
+type ChangesPage struct {
+	Changes []*models.Change
+	// NextSince is the sequence number to continue from: the last change of
+	// this page, or since itself when there was nothing new
+	NextSince uint
+	// HasMore reports whether further changes were already committed
+	HasMore bool
+}
+
+// GetChanges reads up to query.Limit changes committed after the change
+// numbered query.Since, in sequence order, leaving out those the actor may not
+// see. A zero limit uses the default page size and larger limits are capped.
+// Clients sync by passing NextSince back until HasMore is false.
+func (s *IssueService) GetChanges(query domain.ChangesQuery) (*ChangesPage, error) {
+	limit := query.Limit
+	if limit < 0 {
+		return nil, domain.ErrInvalidLimit
+	}
+	if limit == 0 {
+		limit = domain.DefaultChangesLimit
+	}
+	if limit > domain.MaxChangesLimit {
+		limit = domain.MaxChangesLimit
+	}
+
+	page := &ChangesPage{Changes: []*models.Change{}, NextSince: query.Since}
+	err := s.store.View(func(tx repository.Tx) error {
+		last, err := tx.Changes().LastSeq()
+		if err != nil {
+			return err
+		}
+		if query.Since > last {
+			// The client synced with another store, e.g. before a restart of the memory backend
+			return domain.ErrSinceAhead.WithDetail("latest", last)
+		}
+
+		visible, err := resolveProjectScope(tx, domain.IssueListQuery{ActorID: query.ActorID})
+		if err != nil {
+			return err
+		}
+		changes, err := tx.Changes().ListSince(query.Since, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			// Hidden changes still advance the position
+			page.NextSince = change.Seq
+			ok, err := changeVisible(tx, change, visible)
+			if err != nil {
+				return err
+			}
+			if ok {
+				page.Changes = append(page.Changes, change)
+			}
+		}
+		page.HasMore = page.NextSince < last
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// changeVisible reports whether the issues a change is about are visible:
+// the changed issue, or both issues of a link
+func changeVisible(tx repository.Tx, change *models.Change, visible func(*models.Issue) bool) (bool, error) {
+	switch {
+	case change.Issue != nil:
+		return visible(change.Issue), nil
+	case change.Link != nil:
+		for _, id := range []uint{change.Link.SourceID, change.Link.TargetID} {
+			issue, err := tx.Issues().Get(id)
+			if err != nil {
+				return false, err
+			}
+			if !visible(issue) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// recordIssueChange appends a change of an issue to the changes feed, with the
+// issue's labels and comment count as they are at that point
+func recordIssueChange(tx repository.Tx, op string, issue *models.Issue, actor *models.User, at time.Time) error {
+	snapshot := *issue
+	if err := withLabels(tx, &snapshot); err != nil {
+		return err
+	}
+	if err := withCommentCount(tx, &snapshot); err != nil {
+		return err
+	}
+
+	return tx.Changes().Append(&models.Change{
+		Entity:   domain.ChangeEntityIssue,
+		EntityID: issue.ID,
+		Op:       op,
+		Issue:    &snapshot,
+		Actor:    actor,
+		At:       at,
+	})
+}
+
+// recordLinkChange appends the creation or deletion of an issue link to the changes feed
+func recordLinkChange(tx repository.Tx, op string, link *models.IssueLink, at time.Time) error {
+	snapshot := *link
+	return tx.Changes().Append(&models.Change{
+		Entity:   domain.ChangeEntityLink,
+		EntityID: link.ID,
+		Op:       op,
+		Link:     &snapshot,
+		At:       at,
+	})
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"aoroa/internal/domain"
+)
+
+func TestChangesFeedRecordsEveryIssueMutation(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	labelService := NewLabelService(userService)
+
+	label, _ := labelService.CreateLabel(domain.CreateLabelRequest{Name: "bug"}, uintPtr(testAdminID))
+	first, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, Labels: []string{"bug"}, UserID: uintPtr(2)})
+	second, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	link, err := issueService.CreateIssueLink(first.ID, domain.CreateIssueLinkRequest{Type: domain.LinkRelatesTo, IssueID: second.ID})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	// A rejected change takes no sequence number
+	invalid := "UNKNOWN"
+	if _, err := issueService.UpdateIssue(first.ID, domain.UpdateIssueRequest{Status: &invalid}); err == nil {
+		t.Fatal("Expected invalid status to be rejected")
+	}
+
+	if err := issueService.DeleteIssueLink(second.ID, link.ID, nil); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := userService.DeactivateUser(2, testAdminID); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if err := labelService.DeleteLabel(label.ID, uintPtr(testAdminID)); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	page, err := issueService.GetChanges(domain.ChangesQuery{})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	expected := []struct {
+		entity string
+		id     uint
+		op     string
+	}{
+		{domain.ChangeEntityIssue, first.ID, domain.ChangeCreated},
+		{domain.ChangeEntityIssue, second.ID, domain.ChangeCreated},
+		{domain.ChangeEntityLink, link.ID, domain.ChangeCreated},
+		{domain.ChangeEntityLink, link.ID, domain.ChangeDeleted},
+		{domain.ChangeEntityIssue, first.ID, domain.ChangeUpdated}, // unassigned by deactivation
+		{domain.ChangeEntityIssue, first.ID, domain.ChangeUpdated}, // label removed
+	}
+	if len(page.Changes) != len(expected) {
+		t.Fatalf("Expected %d changes, got %d", len(expected), len(page.Changes))
+	}
+	for i, want := range expected {
+		change := page.Changes[i]
+		if change.Seq != uint(i+1) || change.Entity != want.entity || change.EntityID != want.id || change.Op != want.op {
+			t.Errorf("Change %d: expected %d %s %d %s, got %d %s %d %s", i, i+1, want.entity, want.id, want.op,
+				change.Seq, change.Entity, change.EntityID, change.Op)
+		}
+	}
+
+	if created := page.Changes[0].Issue; created == nil || len(created.Labels) != 1 || created.User == nil {
+		t.Errorf("Expected the created issue with its label and assignee, got %+v", created)
+	}
+	if deleted := page.Changes[3].Link; deleted == nil || deleted.SourceID != first.ID || deleted.TargetID != second.ID {
+		t.Errorf("Expected the deleted link, got %+v", deleted)
+	}
+	if last := page.Changes[5].Issue; last == nil || last.User != nil || len(last.LabelIDs) != 0 {
+		t.Errorf("Expected the final issue state, got %+v", last)
+	}
+	if page.NextSince != 6 || page.HasMore {
+		t.Errorf("Expected to be synced at 6, got %d (more: %v)", page.NextSince, page.HasMore)
+	}
+}
+
+func TestChangesFeedPaging(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	for i := 0; i < 5; i++ {
+		issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	}
+
+	var seqs []uint
+	since := uint(0)
+	for {
+		page, err := issueService.GetChanges(domain.ChangesQuery{Since: since, Limit: 2})
+		if err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+		for _, change := range page.Changes {
+			seqs = append(seqs, change.Seq)
+		}
+		since = page.NextSince
+		if !page.HasMore {
+			break
+		}
+	}
+	if len(seqs) != 5 || seqs[0] != 1 || seqs[4] != 5 {
+		t.Fatalf("Expected changes 1 to 5 across pages, got %v", seqs)
+	}
+
+	// Caught up: nothing new, the position stays
+	page, _ := issueService.GetChanges(domain.ChangesQuery{Since: 5})
+	if len(page.Changes) != 0 || page.NextSince != 5 || page.HasMore {
+		t.Errorf("Expected an empty page at 5, got %+v", page)
+	}
+
+	_, err := issueService.GetChanges(domain.ChangesQuery{Since: 6})
+	if !errors.Is(err, domain.ErrSinceAhead) {
+		t.Errorf("Expected since ahead, got %v", err)
+	}
+}
+
+func TestChangesFeedHidesOtherProjects(t *testing.T) {
+	_, issueService := newProjectTestServices(t)
+
+	public, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	internal, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ProjectKey: "WEB", ActorID: uintPtr(testMemberID)})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	issueService.CreateIssueLink(public.ID, domain.CreateIssueLinkRequest{Type: domain.LinkRelatesTo, IssueID: internal.ID, ActorID: uintPtr(testMemberID)})
+
+	page, err := issueService.GetChanges(domain.ChangesQuery{ActorID: uintPtr(testOutsider)})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(page.Changes) != 1 || page.Changes[0].EntityID != public.ID {
+		t.Errorf("Expected only the issue outside projects, got %+v", page.Changes)
+	}
+	// Hidden changes still move the position forward
+	if page.NextSince != 3 || page.HasMore {
+		t.Errorf("Expected to be synced at 3, got %d", page.NextSince)
+	}
+
+	if page, _ := issueService.GetChanges(domain.ChangesQuery{ActorID: uintPtr(testMemberID)}); len(page.Changes) != 3 {
+		t.Errorf("Expected members to see every change, got %d", len(page.Changes))
+	}
+
+	// Anonymous callers are no members either
+	page, err = issueService.GetChanges(domain.ChangesQuery{})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(page.Changes) != 1 || page.Changes[0].EntityID != public.ID || page.NextSince != 3 {
+		t.Errorf("Expected only the issue outside projects for an anonymous caller, got %+v", page.Changes)
+	}
+}
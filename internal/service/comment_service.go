@@ -0,0 +1,210 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+	"aoroa/internal/workflow"
+)
+
+// CommentService handles comment-related operations.
+// Comments follow the same final-state rule as UpdateIssue: once an issue reaches a
+// final workflow state (COMPLETED or CANCELLED by default) its comments can be read
+// but not added, edited or deleted.
+type CommentService struct {
+	store    repository.Store
+	bus      *events.Bus
+	workflow *workflow.Workflow
+}
+
+// NewCommentService creates a new CommentService sharing the issue service's
+// store and event bus
+func NewCommentService(issueService *IssueService) *CommentService {
+	return &CommentService{
+		store:    issueService.store,
+		bus:      issueService.bus,
+		workflow: issueService.workflow,
+	}
+}
+
+// CreateComment adds a comment written by authorID to an issue
+func (s *CommentService) CreateComment(issueID, authorID uint, req domain.CreateCommentRequest) (*models.Comment, error) {
+	body, err := validateCommentBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment *models.Comment
+	err = updateAndPublish(s.store, s.bus, func(tx repository.Tx, emit emitFunc) error {
+		author, err := findUser(tx, authorID)
+		if err != nil {
+			return err
+		}
+		if _, err := s.findCommentableIssue(tx, issueID, author); err != nil {
+			return err
+		}
+		if !author.IsActive() {
+			return domain.ErrUserDeactivated
+		}
+
+		now := time.Now()
+		comment = &models.Comment{
+			IssueID:   issueID,
+			Author:    author,
+			Body:      body,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := tx.Comments().Create(comment); err != nil {
+			return err
+		}
+		emit(events.CommentCreated{Comment: *comment, At: now})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// GetComments returns the comments of an issue in creation order. Comments of
+// a project's issues are only available to its members and admins.
+func (s *CommentService) GetComments(issueID uint, actorID *uint) ([]*models.Comment, error) {
+	var comments []*models.Comment
+
+	err := s.store.View(func(tx repository.Tx) error {
+		issue, err := findIssue(tx, issueID)
+		if err != nil {
+			return err
+		}
+		if err := checkActorIssueAccess(tx, issue, actorID); err != nil {
+			return err
+		}
+
+		comments, err = tx.Comments().ListByIssue(issueID)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			withCurrentAuthor(tx, comment)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// UpdateComment edits a comment; only its author may do so
+func (s *CommentService) UpdateComment(issueID, commentID, actorID uint, req domain.UpdateCommentRequest) (*models.Comment, error) {
+	body, err := validateCommentBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment *models.Comment
+	err = updateAndPublish(s.store, s.bus, func(tx repository.Tx, emit emitFunc) error {
+		var err error
+		comment, err = s.findAuthoredComment(tx, issueID, commentID, actorID)
+		if err != nil {
+			return err
+		}
+
+		comment.Body = body
+		comment.UpdatedAt = time.Now()
+		if err := tx.Comments().Update(comment); err != nil {
+			return err
+		}
+		emit(events.CommentUpdated{Comment: *comment, At: comment.UpdatedAt})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// DeleteComment removes a comment; only its author may do so
+func (s *CommentService) DeleteComment(issueID, commentID, actorID uint) error {
+	return updateAndPublish(s.store, s.bus, func(tx repository.Tx, emit emitFunc) error {
+		comment, err := s.findAuthoredComment(tx, issueID, commentID, actorID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Comments().Delete(comment.ID); err != nil {
+			return err
+		}
+		emit(events.CommentDeleted{Comment: *comment, At: time.Now()})
+		return nil
+	})
+}
+
+// findCommentableIssue loads an issue the actor has access to that still accepts comment changes
+func (s *CommentService) findCommentableIssue(tx repository.Tx, issueID uint, actor *models.User) (*models.Issue, error) {
+	issue, err := findIssue(tx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIssueAccess(tx, issue, actor); err != nil {
+		return nil, err
+	}
+	if s.workflow.IsFinal(issue.Status) {
+		return nil, domain.ErrCommentOnFinalIssue
+	}
+	return issue, nil
+}
+
+// findAuthoredComment loads a comment of an open issue and checks that actorID wrote it
+func (s *CommentService) findAuthoredComment(tx repository.Tx, issueID, commentID, actorID uint) (*models.Comment, error) {
+	// An unknown actor is no member and no author either
+	actor, _ := tx.Users().Get(actorID)
+	if actor != nil && !actor.IsActive() {
+		return nil, domain.ErrActorDeactivated
+	}
+	if _, err := s.findCommentableIssue(tx, issueID, actor); err != nil {
+		return nil, err
+	}
+
+	comment, err := tx.Comments().Get(commentID)
+	if errors.Is(err, repository.ErrNotFound) || (err == nil && comment.IssueID != issueID) {
+		return nil, domain.ErrCommentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Author == nil || comment.Author.ID != actorID {
+		return nil, domain.ErrNotCommentAuthor
+	}
+	withCurrentAuthor(tx, comment)
+	return comment, nil
+}
+
+// withCurrentAuthor replaces the comment's embedded author with the latest user record
+func withCurrentAuthor(tx repository.Tx, comment *models.Comment) *models.Comment {
+	if comment.Author == nil {
+		return comment
+	}
+	if user, err := tx.Users().Get(comment.Author.ID); err == nil {
+		comment.Author = user
+	}
+	return comment
+}
+
+// validateCommentBody trims and checks a comment body
+func validateCommentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", domain.ErrCommentBodyRequired
+	}
+	return body, nil
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"aoroa/internal/domain"
+)
+
+func newCommentTestServices(t *testing.T) (*IssueService, *CommentService, uint) {
+	t.Helper()
+
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	commentService := NewCommentService(issueService)
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, UserID: uintPtr(1)})
+	if err != nil {
+		t.Fatalf("Failed to create test issue: %v", err)
+	}
+	return issueService, commentService, issue.ID
+}
+
+func TestCommentServiceCreateAndList(t *testing.T) {
+	issueService, commentService, issueID := newCommentTestServices(t)
+
+	comment, err := commentService.CreateComment(issueID, 2, domain.CreateCommentRequest{Body: "  looks good  "})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if comment.Body != "looks good" || comment.Author.ID != 2 {
+		t.Errorf("Unexpected comment: %+v", comment)
+	}
+
+	comments, err := commentService.GetComments(issueID, nil)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(comments) != 1 {
+		t.Fatalf("Expected 1 comment, got %d", len(comments))
+	}
+
+	issues, _ := issueService.GetIssues("")
+	if issues[0].CommentCount != 1 {
+		t.Errorf("Expected comment count 1 in listing, got %d", issues[0].CommentCount)
+	}
+}
+
+func TestCommentServiceOnlyAuthorCanModify(t *testing.T) {
+	_, commentService, issueID := newCommentTestServices(t)
+
+	comment, _ := commentService.CreateComment(issueID, 2, domain.CreateCommentRequest{Body: "first"})
+
+	_, err := commentService.UpdateComment(issueID, comment.ID, 3, domain.UpdateCommentRequest{Body: "hijacked"})
+	if err == nil || err.Error() != "only the author can modify a comment" {
+		t.Errorf("Expected author check error, got %v", err)
+	}
+	if err := commentService.DeleteComment(issueID, comment.ID, 3); err == nil {
+		t.Error("Expected error when a non-author deletes a comment")
+	}
+
+	updated, err := commentService.UpdateComment(issueID, comment.ID, 2, domain.UpdateCommentRequest{Body: "edited"})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if updated.Body != "edited" {
+		t.Errorf("Expected body 'edited', got '%s'", updated.Body)
+	}
+
+	if err := commentService.DeleteComment(issueID, comment.ID, 2); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	comments, _ := commentService.GetComments(issueID, nil)
+	if len(comments) != 0 {
+		t.Errorf("Expected no comments after delete, got %d", len(comments))
+	}
+}
+
+func TestCommentServiceRejectsDeactivatedAuthor(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	commentService := NewCommentService(issueService)
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, UserID: uintPtr(1)})
+	if err != nil {
+		t.Fatalf("Failed to create test issue: %v", err)
+	}
+	comment, err := commentService.CreateComment(issue.ID, 2, domain.CreateCommentRequest{Body: "first"})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := userService.DeactivateUser(2, testAdminID); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	if _, err := commentService.UpdateComment(issue.ID, comment.ID, 2, domain.UpdateCommentRequest{Body: "edited"}); !errors.Is(err, domain.ErrActorDeactivated) {
+		t.Errorf("Expected ErrActorDeactivated on update, got %v", err)
+	}
+	if err := commentService.DeleteComment(issue.ID, comment.ID, 2); !errors.Is(err, domain.ErrActorDeactivated) {
+		t.Errorf("Expected ErrActorDeactivated on delete, got %v", err)
+	}
+}
+
+func TestCommentServiceBlocksFinalStateIssues(t *testing.T) {
+	issueService, commentService, issueID := newCommentTestServices(t)
+
+	comment, _ := commentService.CreateComment(issueID, 1, domain.CreateCommentRequest{Body: "before close"})
+
+	completed := domain.StatusCompleted
+	if _, err := issueService.UpdateIssue(issueID, domain.UpdateIssueRequest{Status: &completed}); err != nil {
+		t.Fatalf("Failed to complete issue: %v", err)
+	}
+
+	expected := "cannot comment on issue in final state"
+	if _, err := commentService.CreateComment(issueID, 1, domain.CreateCommentRequest{Body: "after close"}); err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', got %v", expected, err)
+	}
+	if _, err := commentService.UpdateComment(issueID, comment.ID, 1, domain.UpdateCommentRequest{Body: "edit"}); err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', got %v", expected, err)
+	}
+
+	// Reading stays allowed
+	if comments, err := commentService.GetComments(issueID, nil); err != nil || len(comments) != 1 {
+		t.Errorf("Expected to read 1 comment on closed issue, got %d (%v)", len(comments), err)
+	}
+}
+
+func TestCommentServiceRequiresProjectAccess(t *testing.T) {
+	_, issueService := newProjectTestServices(t)
+	commentService := NewCommentService(issueService)
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ProjectKey: "WEB", ActorID: uintPtr(testMemberID)})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	comment, err := commentService.CreateComment(issue.ID, testMemberID, domain.CreateCommentRequest{Body: "member note"})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	if _, err := commentService.CreateComment(issue.ID, testOutsider, domain.CreateCommentRequest{Body: "outsider"}); !errors.Is(err, domain.ErrNotProjectMember) {
+		t.Errorf("Expected not project member error, got %v", err)
+	}
+	for _, actorID := range []*uint{uintPtr(testOutsider), nil} {
+		if _, err := commentService.GetComments(issue.ID, actorID); !errors.Is(err, domain.ErrNotProjectMember) {
+			t.Errorf("Expected not project member error on read, got %v", err)
+		}
+	}
+	if comments, err := commentService.GetComments(issue.ID, uintPtr(testAdminID)); err != nil || len(comments) != 1 {
+		t.Errorf("Expected admins to read 1 comment, got %d (%v)", len(comments), err)
+	}
+
+	// Project access is checked before authorship
+	if err := commentService.DeleteComment(issue.ID, comment.ID, testOutsider); !errors.Is(err, domain.ErrNotProjectMember) {
+		t.Errorf("Expected not project member error on delete, got %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"time"
+
+	"aoroa/internal/events"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+// emitFunc collects the events raised by a change inside its transaction
+type emitFunc func(...events.Event)
+
+// updateAndPublish runs fn in a read-write transaction and publishes the events
+// it emitted once the change is committed. Events of a rolled back change are
+// dropped. Publication follows commit order across concurrent callers.
+func updateAndPublish(store repository.Store, bus *events.Bus, fn func(tx repository.Tx, emit emitFunc) error) error {
+	var pending []events.Event
+	var ticket *events.Ticket
+
+	err := store.Update(func(tx repository.Tx) error {
+		emit := func(e ...events.Event) { pending = append(pending, e...) }
+		if err := fn(tx, emit); err != nil {
+			return err
+		}
+		// Taken last, under the store's write lock, so tickets follow commit order
+		ticket = bus.Reserve()
+		return nil
+	})
+	if err != nil {
+		ticket.Cancel()
+		return err
+	}
+
+	ticket.Publish(pending...)
+	return nil
+}
+
+// issueUpdateEvents describes a committed change of an issue: IssueUpdated with
+// the changed fields, followed by StatusChanged and AssigneeChanged when they apply
+func issueUpdateEvents(before, after *models.Issue, actor *models.User, at time.Time) []events.Event {
+	changes := diffIssue(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	result := []events.Event{events.IssueUpdated{Issue: *after, Changes: changes, Actor: actor, At: at}}
+	if before.Status != after.Status {
+		result = append(result, events.StatusChanged{IssueID: after.ID, From: before.Status, To: after.Status, Actor: actor, At: at})
+	}
+	if !equalValues(userValue(before.User), userValue(after.User)) {
+		result = append(result, events.AssigneeChanged{IssueID: after.ID, From: before.User, To: after.User, Actor: actor, At: at})
+	}
+	return result
+}
+
+// indexEvent keeps the search index in step with created and updated issues
+// and with the comments indexed along with them
+func (s *IssueService) indexEvent(event events.Event) {
+	switch e := event.(type) {
+	case events.IssueCreated:
+		s.reindex(e.Issue.ID)
+	case events.IssueUpdated:
+		s.reindex(e.Issue.ID)
+	case events.CommentCreated:
+		s.reindex(e.Comment.IssueID)
+	case events.CommentUpdated:
+		s.reindex(e.Comment.IssueID)
+	case events.CommentDeleted:
+		s.reindex(e.Comment.IssueID)
+	}
+}
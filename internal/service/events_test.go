@@ -0,0 +1,135 @@
+package service
+
+import (
+	"testing"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
+)
+
+func TestServicesPublishEventsAfterCommit(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+
+	var topics []string
+	var updated events.IssueUpdated
+	userService.Events().Subscribe(func(e events.Event) { topics = append(topics, e.Topic()) })
+	userService.Events().Subscribe(events.On(func(e events.IssueUpdated) { updated = e }))
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{UserID: uintPtr(1)}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	// A rejected change publishes nothing
+	invalid := "UNKNOWN"
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Status: &invalid}); err == nil {
+		t.Fatal("Expected invalid status to be rejected")
+	}
+	if _, err := userService.CreateUser(domain.CreateUserRequest{Name: "New", Email: "new@example.com"}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	expected := []string{
+		events.TopicIssueCreated,
+		events.TopicIssueUpdated, events.TopicStatusChanged, events.TopicAssigneeChanged,
+		events.TopicUserCreated,
+	}
+	if len(topics) != len(expected) {
+		t.Fatalf("Expected topics %v, got %v", expected, topics)
+	}
+	for i := range expected {
+		if topics[i] != expected[i] {
+			t.Fatalf("Expected topics %v, got %v", expected, topics)
+		}
+	}
+
+	fields := make(map[string]bool)
+	for _, change := range updated.Changes {
+		fields[change.Field] = true
+	}
+	if len(fields) != 2 || !fields[HistoryFieldStatus] || !fields[HistoryFieldUser] {
+		t.Errorf("Expected status and assignee changes, got %+v", updated.Changes)
+	}
+}
+
+func TestDeactivationPublishesReleasedIssues(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, UserID: uintPtr(2)})
+
+	var released []events.AssigneeChanged
+	userService.Events().Subscribe(events.On(func(e events.AssigneeChanged) { released = append(released, e) }))
+
+	if _, err := userService.DeactivateUser(2, testAdminID); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(released) != 1 || released[0].IssueID != issue.ID || released[0].To != nil || released[0].Actor != nil {
+		t.Errorf("Expected one unassignment without actor, got %+v", released)
+	}
+}
+
+func TestLabelDeletionPublishesDetachedIssues(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	labelService := NewLabelService(userService)
+	label, _ := labelService.CreateLabel(domain.CreateLabelRequest{Name: "bug"}, uintPtr(testAdminID))
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, Labels: []string{"bug"}})
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+
+	var updated []events.IssueUpdated
+	userService.Events().Subscribe(events.On(func(e events.IssueUpdated) { updated = append(updated, e) }))
+
+	if err := labelService.DeleteLabel(label.ID, uintPtr(testAdminID)); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(updated) != 1 || updated[0].Issue.ID != issue.ID || len(updated[0].Issue.LabelIDs) != 0 || updated[0].Actor == nil || updated[0].Actor.ID != testAdminID {
+		t.Fatalf("Expected one update detaching the label, got %+v", updated)
+	}
+	if len(updated[0].Changes) != 1 || updated[0].Changes[0].Field != HistoryFieldLabels {
+		t.Errorf("Expected a label change, got %+v", updated[0].Changes)
+	}
+}
+
+func TestCommentServicePublishesCommentEvents(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	commentService := NewCommentService(issueService)
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+
+	var published []events.Event
+	userService.Events().Subscribe(func(e events.Event) { published = append(published, e) })
+
+	comment, err := commentService.CreateComment(issue.ID, 1, domain.CreateCommentRequest{Body: "first"})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := commentService.UpdateComment(issue.ID, comment.ID, 1, domain.UpdateCommentRequest{Body: "edited"}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	// Rejected changes publish nothing
+	if _, err := commentService.UpdateComment(issue.ID, comment.ID, 2, domain.UpdateCommentRequest{Body: "not mine"}); err == nil {
+		t.Fatal("Expected an edit by another user to be rejected")
+	}
+	if err := commentService.DeleteComment(issue.ID, comment.ID, 1); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	if len(published) != 3 {
+		t.Fatalf("Expected three comment events, got %+v", published)
+	}
+	created, ok := published[0].(events.CommentCreated)
+	if !ok || created.Comment.ID != comment.ID || created.Key() != events.IssueKey(issue.ID) {
+		t.Errorf("Expected the comment creation first, got %+v", published[0])
+	}
+	updated, ok := published[1].(events.CommentUpdated)
+	if !ok || updated.Comment.Body != "edited" {
+		t.Errorf("Expected the edited comment, got %+v", published[1])
+	}
+	if deleted, ok := published[2].(events.CommentDeleted); !ok || deleted.Comment.ID != comment.ID {
+		t.Errorf("Expected the comment deletion, got %+v", published[2])
+	}
+}
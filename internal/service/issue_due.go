@@ -0,0 +1,101 @@
+package service
+
+import (
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+// OverdueMonitor detects issues that became overdue and publishes an
+// events.IssueOverdue on the domain bus once per issue and due date. Moving the
+// due date or reopening a finished issue re-arms the notification. Notified
+// issues are remembered in memory only, so a restarted server reports issues
+// that are still overdue once more.
+type OverdueMonitor struct {
+	issueService *IssueService
+
+	mu       sync.Mutex
+	notified map[uint]time.Time // issue ID -> due date already reported
+}
+
+// NewOverdueMonitor creates a monitor for the issues of the given service
+func NewOverdueMonitor(issueService *IssueService) *OverdueMonitor {
+	return &OverdueMonitor{
+		issueService: issueService,
+		notified:     make(map[uint]time.Time),
+	}
+}
+
+// Check finds issues overdue at now that have not been reported yet,
+// publishes them on the bus and returns the published events
+func (m *OverdueMonitor) Check(now time.Time) ([]events.IssueOverdue, error) {
+	var overdue []*models.Issue
+	err := m.issueService.store.View(func(tx repository.Tx) error {
+		issues, err := tx.Issues().List()
+		if err != nil {
+			return err
+		}
+		for _, issue := range issues {
+			if m.issueService.isOverdue(issue, now) {
+				withCurrentUser(tx, issue)
+				if err := withLabels(tx, issue); err != nil {
+					return err
+				}
+				overdue = append(overdue, issue)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	current := make(map[uint]time.Time, len(overdue))
+	var published []events.IssueOverdue
+	var pending []events.Event
+	for _, issue := range overdue {
+		due := *issue.DueDate
+		current[issue.ID] = due
+		if reported, ok := m.notified[issue.ID]; ok && reported.Equal(due) {
+			continue
+		}
+		event := events.IssueOverdue{Issue: *issue, DueDate: due, At: now}
+		published = append(published, event)
+		pending = append(pending, event)
+	}
+	// Forget issues that are no longer overdue so they are reported again if they become overdue
+	m.notified = current
+	m.mu.Unlock()
+
+	m.issueService.bus.Publish(pending...)
+	return published, nil
+}
+
+// LogOverdue is a bus subscriber that writes overdue issues to the log
+func LogOverdue(event events.IssueOverdue) {
+	name := event.Issue.Key
+	if name == "" {
+		name = "#" + strconv.FormatUint(uint64(event.Issue.ID), 10)
+	}
+	log.Printf("Issue %s %q is overdue since %s", name, event.Issue.Title, event.DueDate.Format(time.RFC3339))
+}
+
+// isOverdue reports whether an issue is still open after its due date
+func (s *IssueService) isOverdue(issue *models.Issue, now time.Time) bool {
+	return issue.DueDate != nil && now.After(*issue.DueDate) && !s.workflow.IsFinal(issue.Status)
+}
+
+// validateSchedule checks that an issue does not end before it starts
+func validateSchedule(start, due *time.Time) error {
+	if start != nil && due != nil && due.Before(*start) {
+		return domain.ErrDueBeforeStart
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
+)
+
+func TestIssueDatesValidateAndClear(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	start := time.Now()
+	due := start.Add(-time.Hour)
+
+	if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, StartDate: &start, DueDate: &due}); !errors.Is(err, domain.ErrDueBeforeStart) {
+		t.Errorf("Expected due before start error, got %v", err)
+	}
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, DueDate: &due})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{StartDate: &start}); !errors.Is(err, domain.ErrDueBeforeStart) {
+		t.Errorf("Expected due before start error on update, got %v", err)
+	}
+
+	issue, err = issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{RemoveDueDate: true})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if issue.DueDate != nil {
+		t.Errorf("Expected due date to be cleared, got %v", issue.DueDate)
+	}
+}
+
+func TestListIssuesFiltersOverdue(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "late", DueDate: &past})
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "on time", DueDate: &future})
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "no due date"})
+	cancelled, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "late but cancelled", DueDate: &past})
+	status := domain.StatusCancelled
+	issueService.UpdateIssue(cancelled.ID, domain.UpdateIssueRequest{Status: &status})
+
+	overdue, notOverdue := true, false
+	for _, tt := range []struct {
+		overdue *bool
+		want    int
+	}{{&overdue, 1}, {&notOverdue, 3}} {
+		page, err := issueService.ListIssues(domain.IssueListQuery{Filter: domain.IssueFilter{Overdue: tt.overdue}})
+		if err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+		if page.Total != tt.want {
+			t.Errorf("overdue=%v: expected %d issues, got %d", *tt.overdue, tt.want, page.Total)
+		}
+	}
+}
+
+func TestOverdueMonitorNotifiesOncePerDueDate(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	monitor := NewOverdueMonitor(issueService)
+	stream := NewIssueStream(issueService, DefaultStreamReplaySize)
+	var received []events.IssueOverdue
+	userService.Events().Subscribe(events.On(func(event events.IssueOverdue) { received = append(received, event) }))
+
+	due := time.Now().Add(time.Hour)
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, DueDate: &due, UserID: uintPtr(1)})
+
+	if events, _ := monitor.Check(time.Now()); len(events) != 0 {
+		t.Fatalf("Expected no events before the due date, got %v", events)
+	}
+
+	later := due.Add(time.Minute)
+	monitor.Check(later)
+	monitor.Check(later.Add(time.Minute))
+	if len(received) != 1 || received[0].Issue.ID != issue.ID || received[0].Issue.User == nil || received[0].Issue.User.ID != 1 {
+		t.Fatalf("Expected one event for issue %d, got %+v", issue.ID, received)
+	}
+	// Stream subscribers see it like any other issue event
+	if sub, _ := stream.Subscribe(domain.IssueFilter{}, nil, "0"); len(sub.Replay) != 2 || sub.Replay[1].Event.Topic() != events.TopicIssueOverdue {
+		t.Errorf("Expected the overdue event on the issue stream, got %+v", sub.Replay)
+	}
+
+	// Moving the due date re-arms the notification
+	newDue := due.Add(2 * time.Minute)
+	issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{DueDate: &newDue})
+	monitor.Check(newDue.Add(time.Minute))
+	if len(received) != 2 {
+		t.Errorf("Expected a second event after the due date moved, got %d", len(received))
+	}
+}
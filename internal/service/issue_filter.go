@@ -0,0 +1,111 @@
+package service
+
+import (
+	"strings"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+)
+
+// validateIssueFilter checks filter values against the workflow
+func (s *IssueService) validateIssueFilter(filter domain.IssueFilter) error {
+	for _, status := range filter.Statuses {
+		if !s.workflow.IsValidState(status) {
+			return domain.ErrInvalidStatus.WithDetail("status", status)
+		}
+	}
+
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		return domain.ErrInvalidDateRange.WithDetail("field", "createdAfter")
+	}
+	if filter.UpdatedAfter != nil && filter.UpdatedBefore != nil && !filter.UpdatedAfter.Before(*filter.UpdatedBefore) {
+		return domain.ErrInvalidDateRange.WithDetail("field", "updatedAfter")
+	}
+
+	switch filter.LabelMatch {
+	case "", domain.LabelMatchAll, domain.LabelMatchAny:
+	default:
+		return domain.ErrInvalidLabelMatch.WithDetail("labelMatch", filter.LabelMatch)
+	}
+
+	return nil
+}
+
+// matchesIssueFilter reports whether an issue satisfies every criterion of the filter
+func matchesIssueFilter(issue *models.Issue, filter domain.IssueFilter) bool {
+	if len(filter.Statuses) > 0 && !containsString(filter.Statuses, issue.Status) {
+		return false
+	}
+
+	if len(filter.AssigneeIDs) > 0 || filter.Unassigned {
+		if issue.User == nil {
+			if !filter.Unassigned {
+				return false
+			}
+		} else if !containsUint(filter.AssigneeIDs, issue.User.ID) {
+			return false
+		}
+	}
+
+	if filter.CreatedAfter != nil && issue.CreatedAt.Before(*filter.CreatedAfter) {
+		return false
+	}
+	if filter.CreatedBefore != nil && !issue.CreatedAt.Before(*filter.CreatedBefore) {
+		return false
+	}
+	if filter.UpdatedAfter != nil && issue.UpdatedAt.Before(*filter.UpdatedAfter) {
+		return false
+	}
+	if filter.UpdatedBefore != nil && !issue.UpdatedAt.Before(*filter.UpdatedBefore) {
+		return false
+	}
+
+	if len(filter.Labels) > 0 && !matchesLabels(issue, filter.Labels, filter.LabelMatch == domain.LabelMatchAny) {
+		return false
+	}
+
+	if filter.Text != "" {
+		text := strings.ToLower(filter.Text)
+		if !strings.Contains(strings.ToLower(issue.Title), text) && !strings.Contains(strings.ToLower(issue.Description), text) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesLabels reports whether the issue carries every label name, or any of them
+// when matchAny is set. The issue's derived labels must be filled.
+func matchesLabels(issue *models.Issue, names []string, matchAny bool) bool {
+	for _, name := range names {
+		carried := false
+		for _, label := range issue.Labels {
+			if strings.EqualFold(label.Name, name) {
+				carried = true
+				break
+			}
+		}
+		if carried == matchAny {
+			return carried
+		}
+	}
+	return !matchAny
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsUint(values []uint, value uint) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"aoroa/internal/domain"
+)
+
+func TestListIssuesFiltersCombine(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "Login bug", Description: "crash on submit"})
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "Signup page", Description: "login link broken", UserID: uintPtr(1)})
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "Dashboard", UserID: uintPtr(2)})
+	cancelled := domain.StatusCancelled
+	issueService.UpdateIssue(3, domain.UpdateIssueRequest{Status: &cancelled})
+
+	tests := []struct {
+		name   string
+		filter domain.IssueFilter
+		want   []uint
+	}{
+		{"Multiple statuses", domain.IssueFilter{Statuses: []string{domain.StatusPending, domain.StatusCancelled}}, []uint{1, 3}},
+		{"Unassigned", domain.IssueFilter{Unassigned: true}, []uint{1}},
+		{"Assignee or unassigned", domain.IssueFilter{AssigneeIDs: []uint{2}, Unassigned: true}, []uint{1, 3}},
+		{"Text in title or description", domain.IssueFilter{Text: "LOGIN"}, []uint{1, 2}},
+		{"Text and assignee", domain.IssueFilter{Text: "login", AssigneeIDs: []uint{1}}, []uint{2}},
+		{"Created in the future", domain.IssueFilter{CreatedAfter: timePtr(time.Now().Add(time.Hour))}, nil},
+		{"Updated before now", domain.IssueFilter{UpdatedBefore: timePtr(time.Now().Add(time.Second))}, []uint{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := issueService.ListIssues(domain.IssueListQuery{Filter: tt.filter})
+			if err != nil {
+				t.Fatalf(errorUnexpected, err)
+			}
+			if len(page.Issues) != len(tt.want) {
+				t.Fatalf("Expected issues %v, got %d issues", tt.want, len(page.Issues))
+			}
+			for i, issue := range page.Issues {
+				if issue.ID != tt.want[i] {
+					t.Errorf("Expected issue %d at position %d, got %d", tt.want[i], i, issue.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestListIssuesRejectsInvalidFilter(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	now := time.Now()
+
+	if _, err := issueService.ListIssues(domain.IssueListQuery{Filter: domain.IssueFilter{Statuses: []string{domain.StatusPending, "NOPE"}}}); err == nil {
+		t.Error("Expected error for unknown status")
+	}
+	if _, err := issueService.ListIssues(domain.IssueListQuery{Filter: domain.IssueFilter{CreatedAfter: &now, CreatedBefore: &now}}); err == nil {
+		t.Error("Expected error for empty date range")
+	}
+}
+
+// Helper function to create a pointer to time.Time
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"errors"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+// GetIssueChildren returns the sub-tasks of an issue ordered by ID. Sub-tasks
+// share their parent's project, so access to the parent covers them.
+func (s *IssueService) GetIssueChildren(id uint, actorID *uint) ([]models.Issue, error) {
+	var children []models.Issue
+
+	err := s.store.View(func(tx repository.Tx) error {
+		parent, err := findIssue(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkActorIssueAccess(tx, parent, actorID); err != nil {
+			return err
+		}
+
+		issues, err := tx.Issues().List()
+		if err != nil {
+			return err
+		}
+		labels, err := labelsByID(tx)
+		if err != nil {
+			return err
+		}
+		commentCounts, err := tx.Comments().CountByIssue()
+		if err != nil {
+			return err
+		}
+		progress := s.progressByParent(issues)
+
+		children = make([]models.Issue, 0)
+		for _, issue := range issues {
+			if issue.ParentID == nil || *issue.ParentID != id {
+				continue
+			}
+			attachLabels(issue, labels)
+			issue.CommentCount = commentCounts[issue.ID]
+			issue.Progress = progress[issue.ID]
+			children = append(children, *withCurrentUser(tx, issue))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return children, nil
+}
+
+// findParent loads the issue that is to become the parent of child.
+// child is nil for a new issue whose project is given by projectID.
+func (s *IssueService) findParent(tx repository.Tx, parentID uint, child *models.Issue, projectID uint) (*models.Issue, error) {
+	parent, err := tx.Issues().Get(parentID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, domain.ErrParentNotFound.WithDetail("parentId", parentID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if parent.ProjectID != projectID {
+		return nil, domain.ErrParentProjectMismatch
+	}
+	if s.workflow.IsFinal(parent.Status) {
+		return nil, domain.ErrParentClosed
+	}
+
+	if child != nil {
+		// Walk up from the new parent; reaching the child would close a cycle
+		for ancestor := parent; ; {
+			if ancestor.ID == child.ID {
+				return nil, domain.ErrParentCycle
+			}
+			if ancestor.ParentID == nil {
+				break
+			}
+			ancestor, err = tx.Issues().Get(*ancestor.ParentID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return parent, nil
+}
+
+// ensureSubTasksClosed rejects completing an issue while any of its sub-tasks is open
+func (s *IssueService) ensureSubTasksClosed(tx repository.Tx, issue *models.Issue) error {
+	issues, err := tx.Issues().List()
+	if err != nil {
+		return err
+	}
+
+	open := 0
+	for _, child := range issues {
+		if child.ParentID != nil && *child.ParentID == issue.ID && !s.workflow.IsFinal(child.Status) {
+			open++
+		}
+	}
+	if open > 0 {
+		return domain.ErrOpenSubTasks.WithDetail("open", open)
+	}
+	return nil
+}
+
+// progressByParent rolls up sub-task progress for every issue that has sub-tasks
+func (s *IssueService) progressByParent(issues []*models.Issue) map[uint]*models.IssueProgress {
+	progress := make(map[uint]*models.IssueProgress)
+	for _, issue := range issues {
+		if issue.ParentID == nil {
+			continue
+		}
+		p, ok := progress[*issue.ParentID]
+		if !ok {
+			p = &models.IssueProgress{}
+			progress[*issue.ParentID] = p
+		}
+		p.Total++
+		if s.workflow.IsFinal(issue.Status) {
+			p.Closed++
+		}
+	}
+	for _, p := range progress {
+		p.Percent = p.Closed * 100 / p.Total
+	}
+	return progress
+}
+
+// withProgress fills the issue's derived sub-task progress
+func (s *IssueService) withProgress(tx repository.Tx, issue *models.Issue) error {
+	issues, err := tx.Issues().List()
+	if err != nil {
+		return err
+	}
+	issue.Progress = s.progressByParent(issues)[issue.ID]
+	return nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/workflow"
+)
+
+func TestSubTasksRollUpProgress(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+
+	parent, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Parent"})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ParentID: &parent.ID, UserID: uintPtr(1)}); err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+	}
+
+	children, err := issueService.GetIssueChildren(parent.ID, nil)
+	if err != nil || len(children) != 2 {
+		t.Fatalf("Expected 2 children, got %d (%v)", len(children), err)
+	}
+
+	completed := domain.StatusCompleted
+	if _, err := issueService.UpdateIssue(children[0].ID, domain.UpdateIssueRequest{Status: &completed}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	got, err := issueService.GetIssue(parent.ID, nil)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if got.Progress == nil || got.Progress.Total != 2 || got.Progress.Closed != 1 || got.Progress.Percent != 50 {
+		t.Errorf("Expected progress 1/2 (50%%), got %+v", got.Progress)
+	}
+	if children[0].Progress != nil {
+		t.Errorf("Expected no progress on an issue without children, got %+v", children[0].Progress)
+	}
+}
+
+func TestParentCannotCompleteWithOpenSubTasks(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+
+	parent, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Parent", UserID: uintPtr(1)})
+	child, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ParentID: &parent.ID, UserID: uintPtr(2)})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	completed := domain.StatusCompleted
+	if _, err := issueService.UpdateIssue(parent.ID, domain.UpdateIssueRequest{Status: &completed}); !errors.Is(err, domain.ErrOpenSubTasks) {
+		t.Fatalf("Expected open sub-tasks to block completion, got %v", err)
+	}
+
+	if _, err := issueService.UpdateIssue(child.ID, domain.UpdateIssueRequest{Status: &completed}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := issueService.UpdateIssue(parent.ID, domain.UpdateIssueRequest{Status: &completed}); err != nil {
+		t.Errorf("Expected parent to complete once sub-tasks are closed, got %v", err)
+	}
+	if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ParentID: &parent.ID}); !errors.Is(err, domain.ErrParentClosed) {
+		t.Errorf("Expected a closed parent to be rejected, got %v", err)
+	}
+}
+
+func TestParentCannotCloseWithOpenSubTasksInCustomWorkflow(t *testing.T) {
+	wf := &workflow.Workflow{
+		Initial: "TODO",
+		States: []workflow.State{
+			{Name: "TODO"},
+			{Name: "DONE", Final: true},
+			{Name: "DROPPED", Final: true},
+		},
+		Transitions: []workflow.Transition{
+			{To: "DONE"},
+			{To: "DROPPED"},
+		},
+	}
+	issueService := NewIssueServiceWithWorkflow(NewUserService(), wf)
+
+	parent, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Parent"})
+	child, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ParentID: &parent.ID})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	// Every final state closes the parent, not only COMPLETED
+	for _, status := range []string{"DONE", "DROPPED"} {
+		if _, err := issueService.UpdateIssue(parent.ID, domain.UpdateIssueRequest{Status: &status}); !errors.Is(err, domain.ErrOpenSubTasks) {
+			t.Errorf("Expected open sub-tasks to block %s, got %v", status, err)
+		}
+	}
+
+	done := "DONE"
+	if _, err := issueService.UpdateIssue(child.ID, domain.UpdateIssueRequest{Status: &done}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := issueService.UpdateIssue(parent.ID, domain.UpdateIssueRequest{Status: &done}); err != nil {
+		t.Errorf("Expected parent to close once sub-tasks are closed, got %v", err)
+	}
+}
+
+func TestParentReferencesPreventCycles(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+
+	root, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Root"})
+	middle, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Middle", ParentID: &root.ID})
+	leaf, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Leaf", ParentID: &middle.ID})
+
+	if _, err := issueService.UpdateIssue(root.ID, domain.UpdateIssueRequest{ParentID: &leaf.ID}); !errors.Is(err, domain.ErrParentCycle) {
+		t.Errorf("Expected an indirect cycle to be rejected, got %v", err)
+	}
+	if _, err := issueService.UpdateIssue(root.ID, domain.UpdateIssueRequest{ParentID: &root.ID}); !errors.Is(err, domain.ErrParentCycle) {
+		t.Errorf("Expected an issue to be rejected as its own parent, got %v", err)
+	}
+	if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ParentID: uintPtr(999)}); !errors.Is(err, domain.ErrParentNotFound) {
+		t.Errorf("Expected unknown parent to be rejected, got %v", err)
+	}
+
+	leaf, err := issueService.UpdateIssue(leaf.ID, domain.UpdateIssueRequest{RemoveParent: true})
+	if err != nil || leaf.ParentID != nil {
+		t.Fatalf("Expected parent to be removed, got %v (%v)", leaf.ParentID, err)
+	}
+	history, _ := issueService.GetIssueHistory(leaf.ID, nil)
+	last := history[len(history)-1]
+	if last.Field != HistoryFieldParent || last.NewValue != nil {
+		t.Errorf("Expected parent removal in history, got %+v", last)
+	}
+}
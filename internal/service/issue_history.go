@@ -0,0 +1,197 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+// History field names
+const (
+	HistoryFieldTitle       = "title"
+	HistoryFieldDescription = "description"
+	HistoryFieldStatus      = "status"
+	HistoryFieldPriority    = "priority"
+	HistoryFieldSeverity    = "severity"
+	HistoryFieldStartDate   = "startDate"
+	HistoryFieldDueDate     = "dueDate"
+	HistoryFieldParent      = "parentId"
+	HistoryFieldUser        = "userId"
+	HistoryFieldLabels      = "labelIds"
+)
+
+// GetIssueHistory returns the change history of an issue, oldest first
+func (s *IssueService) GetIssueHistory(id uint, actorID *uint) ([]*models.HistoryEntry, error) {
+	var history []*models.HistoryEntry
+
+	err := s.store.View(func(tx repository.Tx) error {
+		issue, err := findIssue(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkActorIssueAccess(tx, issue, actorID); err != nil {
+			return err
+		}
+
+		history, err = tx.History().ListByIssue(id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// recordIssueChanges appends a history entry for every field that differs between
+// before and after, and the change to the changes feed. A nil before records the
+// initial values of a new issue.
+func recordIssueChanges(tx repository.Tx, before, after *models.Issue, actor *models.User, at time.Time) error {
+	for _, change := range diffIssue(before, after) {
+		if before == nil && change.NewValue != nil && *change.NewValue == "" {
+			// Do not record empty initial values such as a missing description
+			continue
+		}
+
+		entry := &models.HistoryEntry{
+			IssueID:  after.ID,
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+			Actor:    actor,
+			At:       at,
+		}
+		if err := tx.History().Append(entry); err != nil {
+			return err
+		}
+	}
+
+	op := domain.ChangeUpdated
+	if before == nil {
+		op = domain.ChangeCreated
+	}
+	return recordIssueChange(tx, op, after, actor, at)
+}
+
+// diffIssue lists every tracked field that differs between before and after.
+// A nil before compares against an issue that does not exist yet.
+func diffIssue(before, after *models.Issue) []events.FieldChange {
+	var previous models.Issue
+	if before != nil {
+		previous = *before
+	}
+
+	fields := []struct {
+		field    string
+		old, new *string
+	}{
+		{HistoryFieldTitle, optionalText(previous.Title, before != nil), optionalText(after.Title, true)},
+		{HistoryFieldDescription, optionalText(previous.Description, before != nil), optionalText(after.Description, true)},
+		{HistoryFieldStatus, optionalText(previous.Status, before != nil), optionalText(after.Status, true)},
+		{HistoryFieldPriority, optionalText(previous.Priority, before != nil && previous.Priority != ""), optionalText(after.Priority, true)},
+		{HistoryFieldSeverity, optionalText(previous.Severity, previous.Severity != ""), optionalText(after.Severity, after.Severity != "")},
+		{HistoryFieldStartDate, timeValue(previous.StartDate), timeValue(after.StartDate)},
+		{HistoryFieldDueDate, timeValue(previous.DueDate), timeValue(after.DueDate)},
+		{HistoryFieldParent, idValue(previous.ParentID), idValue(after.ParentID)},
+		{HistoryFieldUser, userValue(previous.User), userValue(after.User)},
+		{HistoryFieldLabels, labelsValue(previous.LabelIDs), labelsValue(after.LabelIDs)},
+	}
+
+	var changes []events.FieldChange
+	for _, f := range fields {
+		if !equalValues(f.old, f.new) {
+			changes = append(changes, events.FieldChange{Field: f.field, OldValue: f.old, NewValue: f.new})
+		}
+	}
+	return changes
+}
+
+// findActor resolves the optional acting user of a request
+func findActor(tx repository.Tx, actorID *uint) (*models.User, error) {
+	if actorID == nil {
+		return nil, nil
+	}
+	return findActingUser(tx, *actorID)
+}
+
+// findActingUser loads the user performing a request; deactivated users can no longer act
+func findActingUser(tx repository.Tx, id uint) (*models.User, error) {
+	user, err := findUser(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive() {
+		return nil, domain.ErrActorDeactivated
+	}
+	return user, nil
+}
+
+// checkActorIssueAccess resolves the optional acting user and checks their access to the issue
+func checkActorIssueAccess(tx repository.Tx, issue *models.Issue, actorID *uint) error {
+	actor, err := findActor(tx, actorID)
+	if err != nil {
+		return err
+	}
+	return checkIssueAccess(tx, issue, actor)
+}
+
+// optionalText returns a pointer to value, or nil when the value is absent
+func optionalText(value string, present bool) *string {
+	if !present {
+		return nil
+	}
+	return &value
+}
+
+// userValue returns the user's ID as text, or nil when there is no user
+func userValue(user *models.User) *string {
+	if user == nil {
+		return nil
+	}
+	id := strconv.FormatUint(uint64(user.ID), 10)
+	return &id
+}
+
+// idValue returns the referenced ID as text, or nil when there is no reference
+func idValue(id *uint) *string {
+	if id == nil {
+		return nil
+	}
+	value := strconv.FormatUint(uint64(*id), 10)
+	return &value
+}
+
+// timeValue returns the time in RFC 3339 format, or nil when there is no time
+func timeValue(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	value := t.UTC().Format(time.RFC3339)
+	return &value
+}
+
+// labelsValue returns the label IDs as comma-separated text, or nil when there are none
+func labelsValue(ids []uint) *string {
+	if len(ids) == 0 {
+		return nil
+	}
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatUint(uint64(id), 10)
+	}
+	value := strings.Join(parts, ",")
+	return &value
+}
+
+// equalValues compares two optional values
+func equalValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"testing"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+)
+
+func TestIssueHistoryRecordsCreation(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, UserID: uintPtr(2), ActorID: uintPtr(3)})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	history, err := issueService.GetIssueHistory(issue.ID, nil)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	// title, status, priority and user; the empty description and severity are not recorded
+	if len(history) != 4 {
+		t.Fatalf("Expected 4 history entries, got %d", len(history))
+	}
+	for _, entry := range history {
+		if entry.OldValue != nil {
+			t.Errorf("Expected no old value for initial %s, got %s", entry.Field, *entry.OldValue)
+		}
+		if entry.Actor == nil || entry.Actor.ID != 3 {
+			t.Errorf("Expected actor 3 for %s", entry.Field)
+		}
+	}
+}
+
+func TestIssueHistoryRecordsStatusChangeWithActor(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, UserID: uintPtr(1)})
+
+	cancelled := domain.StatusCancelled
+	_, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Status: &cancelled, ActorID: uintPtr(2)})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	history, _ := issueService.GetIssueHistory(issue.ID, nil)
+	last := history[len(history)-1]
+
+	assertHistoryEntry(t, last, HistoryFieldStatus, domain.StatusInProgress, domain.StatusCancelled)
+	if last.Actor == nil || last.Actor.ID != 2 {
+		t.Errorf("Expected actor 2, got %v", last.Actor)
+	}
+}
+
+func TestIssueHistoryRecordsUserRemoval(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, UserID: uintPtr(1)})
+	created, _ := issueService.GetIssueHistory(issue.ID, nil)
+
+	_, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{RemoveUser: true})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	history, _ := issueService.GetIssueHistory(issue.ID, nil)
+	changes := history[len(created):]
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 new entries (status and user), got %d", len(changes))
+	}
+	assertHistoryEntry(t, changes[0], HistoryFieldStatus, domain.StatusInProgress, domain.StatusPending)
+	if changes[1].Field != HistoryFieldUser || changes[1].NewValue != nil {
+		t.Errorf("Expected user removal entry, got %+v", changes[1])
+	}
+	if changes[0].Actor != nil {
+		t.Error("Expected anonymous change to have no actor")
+	}
+}
+
+func TestIssueHistoryFailedUpdateRecordsNothing(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	before, _ := issueService.GetIssueHistory(issue.ID, nil)
+
+	completed := domain.StatusCompleted
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Status: &completed}); err == nil {
+		t.Fatal(errorExpectedNone)
+	}
+
+	after, _ := issueService.GetIssueHistory(issue.ID, nil)
+	if len(after) != len(before) {
+		t.Errorf("Expected history to be unchanged, got %d entries instead of %d", len(after), len(before))
+	}
+}
+
+// Helper function to assert a history entry's field and values
+func assertHistoryEntry(t *testing.T, entry *models.HistoryEntry, field, oldValue, newValue string) {
+	t.Helper()
+
+	if entry.Field != field {
+		t.Errorf("Expected field %s, got %s", field, entry.Field)
+	}
+	if entry.OldValue == nil || *entry.OldValue != oldValue {
+		t.Errorf("Expected old value %s, got %v", oldValue, entry.OldValue)
+	}
+	if entry.NewValue == nil || *entry.NewValue != newValue {
+		t.Errorf("Expected new value %s, got %v", newValue, entry.NewValue)
+	}
+}
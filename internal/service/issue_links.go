@@ -0,0 +1,266 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+// IssueLinkView is a link as seen from one of the linked issues
+type IssueLinkView struct {
+	ID        uint        `json:"id"`
+	Type      string      `json:"type"` // from the viewing issue's side, e.g. blocked_by
+	Issue     LinkedIssue `json:"issue"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+// LinkedIssue summarizes the issue at the other end of a link
+type LinkedIssue struct {
+	ID     uint   `json:"id"`
+	Key    string `json:"key,omitempty"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
+
+// CreateIssueLink links an issue to another. The link type is read from the
+// first issue's side, so "blocked_by" stores the other issue as the blocker.
+// The actor must have access to the projects of both issues.
+func (s *IssueService) CreateIssueLink(issueID uint, req domain.CreateIssueLinkRequest) (*IssueLinkView, error) {
+	if !domain.IsValidLinkType(req.Type) {
+		return nil, domain.ErrInvalidLinkType.WithDetail("type", req.Type)
+	}
+	if req.IssueID == issueID {
+		return nil, domain.ErrSelfLink
+	}
+
+	var view *IssueLinkView
+	err := s.store.Update(func(tx repository.Tx) error {
+		actor, err := findActor(tx, req.ActorID)
+		if err != nil {
+			return err
+		}
+		issue, err := findIssue(tx, issueID)
+		if err != nil {
+			return err
+		}
+		if err := checkIssueAccess(tx, issue, actor); err != nil {
+			return err
+		}
+		other, err := tx.Issues().Get(req.IssueID)
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.ErrLinkedIssueNotFound.WithDetail("issueId", req.IssueID)
+		}
+		if err != nil {
+			return err
+		}
+		if err := checkIssueAccess(tx, other, actor); err != nil {
+			return err
+		}
+
+		// Store the link in its forward direction
+		link := &models.IssueLink{SourceID: issueID, TargetID: other.ID, Type: req.Type, CreatedAt: time.Now()}
+		if req.Type == domain.LinkBlockedBy || req.Type == domain.LinkDuplicatedBy {
+			link.SourceID, link.TargetID = link.TargetID, link.SourceID
+			link.Type = domain.InverseLinkType(req.Type)
+		}
+
+		links, err := tx.Links().List()
+		if err != nil {
+			return err
+		}
+		for _, existing := range links {
+			if sameLink(existing, link) {
+				return domain.ErrLinkExists.WithDetail("linkId", existing.ID)
+			}
+		}
+		if link.Type == domain.LinkBlocks && blocksTransitively(links, link.TargetID, link.SourceID) {
+			return domain.ErrBlockingCycle
+		}
+
+		if err := tx.Links().Create(link); err != nil {
+			return err
+		}
+		if err := recordLinkChange(tx, domain.ChangeCreated, link, link.CreatedAt); err != nil {
+			return err
+		}
+		view = linkView(link, issueID, other)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return view, nil
+}
+
+// GetIssueLinks returns the links of an issue ordered by ID. The actor must
+// have access to the issue, and links to issues they may not see are left out.
+func (s *IssueService) GetIssueLinks(issueID uint, actorID *uint) ([]IssueLinkView, error) {
+	var views []IssueLinkView
+
+	err := s.store.View(func(tx repository.Tx) error {
+		issue, err := findIssue(tx, issueID)
+		if err != nil {
+			return err
+		}
+		if err := checkActorIssueAccess(tx, issue, actorID); err != nil {
+			return err
+		}
+		visible, err := resolveProjectScope(tx, domain.IssueListQuery{ActorID: actorID})
+		if err != nil {
+			return err
+		}
+		links, err := tx.Links().ListByIssue(issueID)
+		if err != nil {
+			return err
+		}
+
+		views = make([]IssueLinkView, 0, len(links))
+		for _, link := range links {
+			otherID := link.TargetID
+			if otherID == issueID {
+				otherID = link.SourceID
+			}
+			other, err := tx.Issues().Get(otherID)
+			if err != nil {
+				return err
+			}
+			if !visible(other) {
+				continue
+			}
+			views = append(views, *linkView(link, issueID, other))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return views, nil
+}
+
+// DeleteIssueLink removes a link from either of its issues. The actor must
+// have access to the projects of both linked issues.
+func (s *IssueService) DeleteIssueLink(issueID, linkID uint, actorID *uint) error {
+	return s.store.Update(func(tx repository.Tx) error {
+		actor, err := findActor(tx, actorID)
+		if err != nil {
+			return err
+		}
+		issue, err := findIssue(tx, issueID)
+		if err != nil {
+			return err
+		}
+		if err := checkIssueAccess(tx, issue, actor); err != nil {
+			return err
+		}
+		link, err := tx.Links().Get(linkID)
+		if errors.Is(err, repository.ErrNotFound) || (err == nil && link.SourceID != issueID && link.TargetID != issueID) {
+			return domain.ErrLinkNotFound.WithDetail("linkId", linkID)
+		}
+		if err != nil {
+			return err
+		}
+		otherID := link.TargetID
+		if otherID == issueID {
+			otherID = link.SourceID
+		}
+		other, err := findIssue(tx, otherID)
+		if err != nil {
+			return err
+		}
+		if err := checkIssueAccess(tx, other, actor); err != nil {
+			return err
+		}
+		if err := tx.Links().Delete(linkID); err != nil {
+			return err
+		}
+		return recordLinkChange(tx, domain.ChangeDeleted, link, time.Now())
+	})
+}
+
+// ensureUnblocked rejects starting or completing an issue while an open issue blocks it
+func (s *IssueService) ensureUnblocked(tx repository.Tx, issue *models.Issue) error {
+	links, err := tx.Links().ListByIssue(issue.ID)
+	if err != nil {
+		return err
+	}
+
+	var blockers []uint
+	for _, link := range links {
+		if link.Type != domain.LinkBlocks || link.TargetID != issue.ID {
+			continue
+		}
+		blocker, err := tx.Issues().Get(link.SourceID)
+		if err != nil {
+			return err
+		}
+		if !s.workflow.IsFinal(blocker.Status) {
+			blockers = append(blockers, blocker.ID)
+		}
+	}
+	if len(blockers) > 0 {
+		return domain.ErrBlockedByOpenIssue.WithDetail("blockedBy", blockers)
+	}
+	return nil
+}
+
+// sameLink reports whether two forward links connect the same issues the same way
+func sameLink(a, b *models.IssueLink) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	if a.SourceID == b.SourceID && a.TargetID == b.TargetID {
+		return true
+	}
+	return a.Type == domain.LinkRelatesTo && a.SourceID == b.TargetID && a.TargetID == b.SourceID
+}
+
+// blocksTransitively reports whether from blocks to through a chain of blocks links
+func blocksTransitively(links []*models.IssueLink, from, to uint) bool {
+	blocked := make(map[uint][]uint)
+	for _, link := range links {
+		if link.Type == domain.LinkBlocks {
+			blocked[link.SourceID] = append(blocked[link.SourceID], link.TargetID)
+		}
+	}
+
+	visited := map[uint]bool{from: true}
+	queue := []uint{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == to {
+			return true
+		}
+		for _, next := range blocked[current] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// linkView presents link from the side of viewerID; other is the issue at the opposite end
+func linkView(link *models.IssueLink, viewerID uint, other *models.Issue) *IssueLinkView {
+	linkType := link.Type
+	if link.TargetID == viewerID {
+		linkType = domain.InverseLinkType(linkType)
+	}
+	return &IssueLinkView{
+		ID:   link.ID,
+		Type: linkType,
+		Issue: LinkedIssue{
+			ID:     other.ID,
+			Key:    other.Key,
+			Title:  other.Title,
+			Status: other.Status,
+		},
+		CreatedAt: link.CreatedAt,
+	}
+}
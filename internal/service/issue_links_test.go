@@ -0,0 +1,166 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/workflow"
+)
+
+func TestIssueLinksAreViewedFromBothSides(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	first, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "First"})
+	second, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Second"})
+
+	link, err := issueService.CreateIssueLink(second.ID, domain.CreateIssueLinkRequest{Type: domain.LinkBlockedBy, IssueID: first.ID})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if link.Type != domain.LinkBlockedBy || link.Issue.ID != first.ID {
+		t.Errorf("Expected link blocked_by #%d, got %s #%d", first.ID, link.Type, link.Issue.ID)
+	}
+
+	links, err := issueService.GetIssueLinks(first.ID, nil)
+	if err != nil || len(links) != 1 {
+		t.Fatalf("Expected 1 link, got %d (%v)", len(links), err)
+	}
+	if links[0].Type != domain.LinkBlocks || links[0].Issue.ID != second.ID {
+		t.Errorf("Expected link blocks #%d, got %s #%d", second.ID, links[0].Type, links[0].Issue.ID)
+	}
+
+	if _, err := issueService.CreateIssueLink(first.ID, domain.CreateIssueLinkRequest{Type: domain.LinkBlocks, IssueID: second.ID}); !errors.Is(err, domain.ErrLinkExists) {
+		t.Errorf("Expected duplicate link to be rejected, got %v", err)
+	}
+	if _, err := issueService.CreateIssueLink(first.ID, domain.CreateIssueLinkRequest{Type: domain.LinkRelatesTo, IssueID: first.ID}); !errors.Is(err, domain.ErrSelfLink) {
+		t.Errorf("Expected self link to be rejected, got %v", err)
+	}
+	if _, err := issueService.CreateIssueLink(first.ID, domain.CreateIssueLinkRequest{Type: "causes", IssueID: second.ID}); !errors.Is(err, domain.ErrInvalidLinkType) {
+		t.Errorf("Expected unknown link type to be rejected, got %v", err)
+	}
+
+	if err := issueService.DeleteIssueLink(first.ID, link.ID, nil); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if err := issueService.DeleteIssueLink(first.ID, link.ID, nil); !errors.Is(err, domain.ErrLinkNotFound) {
+		t.Errorf("Expected deleted link to be gone, got %v", err)
+	}
+}
+
+func TestBlockingLinksRejectCycles(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	a, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "A"})
+	b, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "B"})
+	c, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "C"})
+
+	for _, pair := range [][2]uint{{a.ID, b.ID}, {b.ID, c.ID}} {
+		if _, err := issueService.CreateIssueLink(pair[0], domain.CreateIssueLinkRequest{Type: domain.LinkBlocks, IssueID: pair[1]}); err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+	}
+
+	if _, err := issueService.CreateIssueLink(c.ID, domain.CreateIssueLinkRequest{Type: domain.LinkBlocks, IssueID: a.ID}); !errors.Is(err, domain.ErrBlockingCycle) {
+		t.Errorf("Expected blocking cycle to be rejected, got %v", err)
+	}
+	if _, err := issueService.CreateIssueLink(c.ID, domain.CreateIssueLinkRequest{Type: domain.LinkRelatesTo, IssueID: a.ID}); err != nil {
+		t.Errorf("Expected non-blocking link to be allowed, got %v", err)
+	}
+}
+
+func TestBlockedIssueCannotStartOrComplete(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	blocker, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Blocker", UserID: uintPtr(1)})
+	blocked, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Blocked"})
+	if _, err := issueService.CreateIssueLink(blocker.ID, domain.CreateIssueLinkRequest{Type: domain.LinkBlocks, IssueID: blocked.ID}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	_, err := issueService.UpdateIssue(blocked.ID, domain.UpdateIssueRequest{UserID: uintPtr(2)})
+	if !errors.Is(err, domain.ErrBlockedByOpenIssue) {
+		t.Fatalf("Expected assignment moving to IN_PROGRESS to be blocked, got %v", err)
+	}
+
+	completed := domain.StatusCompleted
+	if _, err := issueService.UpdateIssue(blocker.ID, domain.UpdateIssueRequest{Status: &completed}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := issueService.UpdateIssue(blocked.ID, domain.UpdateIssueRequest{UserID: uintPtr(2)}); err != nil {
+		t.Errorf("Expected issue to start once its blocker is closed, got %v", err)
+	}
+}
+
+func TestBlockerGuardFollowsCustomWorkflow(t *testing.T) {
+	wf := &workflow.Workflow{
+		Initial: "TODO",
+		States: []workflow.State{
+			{Name: "TODO"},
+			{Name: "DOING"},
+			{Name: "DONE", Final: true},
+			{Name: "DROPPED", Final: true},
+		},
+		Transitions: []workflow.Transition{
+			{To: "DOING", Guards: []string{workflow.GuardRequiresUnblocked}},
+			{To: "DONE", Guards: []string{workflow.GuardRequiresUnblocked}},
+			{To: "DROPPED"},
+		},
+	}
+	issueService := NewIssueServiceWithWorkflow(NewUserService(), wf)
+	blocker, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Blocker"})
+	blocked, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Blocked"})
+	if _, err := issueService.CreateIssueLink(blocker.ID, domain.CreateIssueLinkRequest{Type: domain.LinkBlocks, IssueID: blocked.ID}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	for _, status := range []string{"DOING", "DONE"} {
+		if _, err := issueService.UpdateIssue(blocked.ID, domain.UpdateIssueRequest{Status: &status}); !errors.Is(err, domain.ErrBlockedByOpenIssue) {
+			t.Errorf("Expected %s to be blocked, got %v", status, err)
+		}
+	}
+	// Transitions without the guard are not blocked
+	dropped := "DROPPED"
+	if _, err := issueService.UpdateIssue(blocked.ID, domain.UpdateIssueRequest{Status: &dropped}); err != nil {
+		t.Errorf("Expected an unguarded transition to pass, got %v", err)
+	}
+}
+
+func TestIssueLinksRequireAccessToBothIssues(t *testing.T) {
+	_, issueService := newProjectTestServices(t)
+	public, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	internal, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ProjectKey: "WEB", ActorID: uintPtr(testMemberID)})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	// Linking to an issue of another project needs its membership too
+	req := domain.CreateIssueLinkRequest{Type: domain.LinkRelatesTo, IssueID: internal.ID, ActorID: uintPtr(testOutsider)}
+	if _, err := issueService.CreateIssueLink(public.ID, req); !errors.Is(err, domain.ErrNotProjectMember) {
+		t.Errorf("Expected not project member error, got %v", err)
+	}
+	req.ActorID = nil
+	if _, err := issueService.CreateIssueLink(public.ID, req); !errors.Is(err, domain.ErrNotProjectMember) {
+		t.Errorf("Expected anonymous callers to be rejected, got %v", err)
+	}
+	req.ActorID = uintPtr(testMemberID)
+	link, err := issueService.CreateIssueLink(public.ID, req)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	// Outsiders see the public issue but not its link into the project
+	if links, err := issueService.GetIssueLinks(public.ID, uintPtr(testOutsider)); err != nil || len(links) != 0 {
+		t.Errorf("Expected the link to be hidden, got %+v (%v)", links, err)
+	}
+	if _, err := issueService.GetIssueLinks(internal.ID, uintPtr(testOutsider)); !errors.Is(err, domain.ErrNotProjectMember) {
+		t.Errorf("Expected not project member error, got %v", err)
+	}
+	if links, err := issueService.GetIssueLinks(public.ID, uintPtr(testMemberID)); err != nil || len(links) != 1 {
+		t.Errorf("Expected the member to see the link, got %+v (%v)", links, err)
+	}
+
+	if err := issueService.DeleteIssueLink(public.ID, link.ID, uintPtr(testOutsider)); !errors.Is(err, domain.ErrNotProjectMember) {
+		t.Errorf("Expected not project member error, got %v", err)
+	}
+	if err := issueService.DeleteIssueLink(public.ID, link.ID, uintPtr(testMemberID)); err != nil {
+		t.Errorf(errorUnexpected, err)
+	}
+}
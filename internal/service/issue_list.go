@@ -0,0 +1,250 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+// IssuePage is one page of an issue listing
+type IssuePage struct {
+	Issues []models.Issue
+	// Total is the number of issues matching the query across all pages
+	Total int
+	// NextCursor continues the listing after the last issue of this page; empty on the last page
+	NextCursor string
+}
+
+// issueCursor is the decoded form of a listing cursor. It records the sort
+// position of the last issue returned so paging is stable under inserts.
+type issueCursor struct {
+	Sort  string `json:"s"`
+	Order string `json:"o"`
+	Key   string `json:"k"`
+	ID    uint   `json:"id"`
+}
+
+// ListIssues returns a sorted page of issues matching the query
+func (s *IssueService) ListIssues(query domain.IssueListQuery) (*IssuePage, error) {
+	query, err := s.normalizeListQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var after *issueCursor
+	if query.Cursor != "" {
+		after, err = decodeIssueCursor(query.Cursor)
+		if err != nil || after.Sort != query.Sort || after.Order != query.Order {
+			return nil, domain.ErrInvalidCursor
+		}
+	}
+
+	var matched []*models.Issue
+	err = s.store.View(func(tx repository.Tx) error {
+		visible, err := resolveProjectScope(tx, query)
+		if err != nil {
+			return err
+		}
+		issues, err := tx.Issues().List()
+		if err != nil {
+			return err
+		}
+		commentCounts, err := tx.Comments().CountByIssue()
+		if err != nil {
+			return err
+		}
+		labels, err := labelsByID(tx)
+		if err != nil {
+			return err
+		}
+		progress := s.progressByParent(issues)
+		now := time.Now()
+		for _, issue := range issues {
+			attachLabels(issue, labels)
+			if query.Filter.Overdue != nil && s.isOverdue(issue, now) != *query.Filter.Overdue {
+				continue
+			}
+			if visible(issue) && matchesIssueFilter(issue, query.Filter) {
+				issue.CommentCount = commentCounts[issue.ID]
+				issue.Progress = progress[issue.ID]
+				matched = append(matched, withCurrentUser(tx, issue))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	less := issueOrder(query.Sort, query.Order)
+	sort.Slice(matched, func(i, j int) bool { return less(matched[i], matched[j]) })
+
+	page := &IssuePage{Total: len(matched)}
+
+	start := 0
+	if after != nil {
+		start = sort.Search(len(matched), func(i int) bool {
+			return compareSortPosition(matched[i], query.Sort, after.Key, after.ID, query.Order) > 0
+		})
+	}
+
+	end := len(matched)
+	if query.Limit > 0 && start+query.Limit < end {
+		end = start + query.Limit
+	}
+
+	page.Issues = make([]models.Issue, 0, end-start)
+	for _, issue := range matched[start:end] {
+		page.Issues = append(page.Issues, *issue)
+	}
+
+	if end < len(matched) && end > start {
+		last := matched[end-1]
+		page.NextCursor = encodeIssueCursor(issueCursor{
+			Sort:  query.Sort,
+			Order: query.Order,
+			Key:   issueSortKey(last, query.Sort),
+			ID:    last.ID,
+		})
+	}
+
+	return page, nil
+}
+
+// resolveProjectScope returns which issues the query may see by project.
+// Requested project keys restrict the listing to those projects; a non-admin
+// actor additionally sees only projects they belong to plus issues created
+// outside any project, and an anonymous one only the latter.
+func resolveProjectScope(tx repository.Tx, query domain.IssueListQuery) (func(*models.Issue) bool, error) {
+	actor, err := findActor(tx, query.ActorID)
+	if err != nil {
+		return nil, err
+	}
+	restricted := !isAdmin(actor)
+
+	if len(query.Filter.ProjectKeys) > 0 {
+		selected := make(map[uint]bool)
+		for _, key := range query.Filter.ProjectKeys {
+			project, err := findProjectByKey(tx, key)
+			if err != nil {
+				return nil, err
+			}
+			if restricted && !canAccessProject(actor, project) {
+				return nil, domain.ErrNotProjectMember.WithDetail("key", project.Key)
+			}
+			selected[project.ID] = true
+		}
+		return func(issue *models.Issue) bool { return selected[issue.ProjectID] }, nil
+	}
+
+	if !restricted {
+		return func(*models.Issue) bool { return true }, nil
+	}
+
+	projects, err := tx.Projects().List()
+	if err != nil {
+		return nil, err
+	}
+	member := make(map[uint]bool)
+	for _, project := range projects {
+		if canAccessProject(actor, project) {
+			member[project.ID] = true
+		}
+	}
+	return func(issue *models.Issue) bool { return issue.ProjectID == 0 || member[issue.ProjectID] }, nil
+}
+
+// normalizeListQuery validates a listing query and fills in defaults
+func (s *IssueService) normalizeListQuery(query domain.IssueListQuery) (domain.IssueListQuery, error) {
+	if err := s.validateIssueFilter(query.Filter); err != nil {
+		return query, err
+	}
+
+	if query.Sort == "" {
+		query.Sort = domain.SortByID
+	}
+	if !domain.IsValidSortField(query.Sort) {
+		return query, domain.ErrInvalidSortField
+	}
+
+	query.Order = strings.ToLower(query.Order)
+	if query.Order == "" {
+		query.Order = domain.SortAscending
+	}
+	if query.Order != domain.SortAscending && query.Order != domain.SortDescending {
+		return query, domain.ErrInvalidSortOrder
+	}
+
+	if query.Limit < 0 {
+		return query, domain.ErrInvalidLimit
+	}
+
+	return query, nil
+}
+
+// issueOrder returns a strict ordering of issues by the sort key, breaking ties by ID
+func issueOrder(field, order string) func(a, b *models.Issue) bool {
+	return func(a, b *models.Issue) bool {
+		return compareSortPosition(a, field, issueSortKey(b, field), b.ID, order) < 0
+	}
+}
+
+// compareSortPosition compares an issue's position with the given sort key and ID,
+// returning a negative number when the issue comes first in the requested order
+func compareSortPosition(issue *models.Issue, field, key string, id uint, order string) int {
+	result := strings.Compare(issueSortKey(issue, field), key)
+	if result == 0 {
+		switch {
+		case issue.ID < id:
+			result = -1
+		case issue.ID > id:
+			result = 1
+		}
+	}
+	if order == domain.SortDescending {
+		result = -result
+	}
+	return result
+}
+
+// issueSortKey encodes the sort field of an issue so that byte order equals sort order
+func issueSortKey(issue *models.Issue, field string) string {
+	switch field {
+	case domain.SortByCreatedAt:
+		return fmt.Sprintf("%020d", issue.CreatedAt.UnixNano())
+	case domain.SortByUpdatedAt:
+		return fmt.Sprintf("%020d", issue.UpdatedAt.UnixNano())
+	case domain.SortByTitle:
+		return strings.ToLower(issue.Title)
+	case domain.SortByStatus:
+		return issue.Status
+	case domain.SortByPriority:
+		return issuePriority(issue)
+	default:
+		return fmt.Sprintf("%020d", issue.ID)
+	}
+}
+
+func encodeIssueCursor(cursor issueCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeIssueCursor(value string) (*issueCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	var cursor issueCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"testing"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+)
+
+func createTitledIssues(t *testing.T, issueService *IssueService, titles ...string) {
+	t.Helper()
+
+	for _, title := range titles {
+		if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: title}); err != nil {
+			t.Fatalf("Failed to create test issue: %v", err)
+		}
+	}
+}
+
+func issueTitles(issues []models.Issue) []string {
+	titles := make([]string, len(issues))
+	for i, issue := range issues {
+		titles[i] = issue.Title
+	}
+	return titles
+}
+
+func TestListIssuesSortsByTitleDescending(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	createTitledIssues(t, issueService, "banana", "Apple", "cherry")
+
+	page, err := issueService.ListIssues(domain.IssueListQuery{Sort: domain.SortByTitle, Order: domain.SortDescending})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	got := issueTitles(page.Issues)
+	want := []string{"cherry", "banana", "Apple"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected order %v, got %v", want, got)
+		}
+	}
+}
+
+func TestListIssuesPaginatesWithCursor(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	createTitledIssues(t, issueService, "one", "two", "three", "four", "five")
+
+	var seen []uint
+	query := domain.IssueListQuery{Limit: 2}
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatal("Pagination did not terminate")
+		}
+
+		page, err := issueService.ListIssues(query)
+		if err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+		if page.Total != 5 {
+			t.Errorf("Expected total 5, got %d", page.Total)
+		}
+		for _, issue := range page.Issues {
+			seen = append(seen, issue.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		query.Cursor = page.NextCursor
+	}
+
+	if len(seen) != 5 {
+		t.Fatalf("Expected 5 issues across pages, got %v", seen)
+	}
+	for i, id := range seen {
+		if id != uint(i+1) {
+			t.Fatalf("Expected IDs in order 1..5, got %v", seen)
+		}
+	}
+}
+
+func TestListIssuesCursorIsStableUnderInserts(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	createTitledIssues(t, issueService, "b", "d")
+
+	first, _ := issueService.ListIssues(domain.IssueListQuery{Sort: domain.SortByTitle, Limit: 1})
+	createTitledIssues(t, issueService, "a")
+
+	second, err := issueService.ListIssues(domain.IssueListQuery{Sort: domain.SortByTitle, Limit: 1, Cursor: first.NextCursor})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(second.Issues) != 1 || second.Issues[0].Title != "d" {
+		t.Errorf("Expected second page to continue with 'd', got %v", issueTitles(second.Issues))
+	}
+}
+
+func TestListIssuesRejectsInvalidQuery(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	createTitledIssues(t, issueService, "one", "two")
+
+	page, _ := issueService.ListIssues(domain.IssueListQuery{Limit: 1})
+
+	tests := []struct {
+		name  string
+		query domain.IssueListQuery
+	}{
+		{"Unknown sort field", domain.IssueListQuery{Sort: "assignee"}},
+		{"Unknown order", domain.IssueListQuery{Order: "sideways"}},
+		{"Garbage cursor", domain.IssueListQuery{Cursor: "not-a-cursor"}},
+		{"Cursor from a different sort", domain.IssueListQuery{Sort: domain.SortByTitle, Cursor: page.NextCursor}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := issueService.ListIssues(tt.query); err == nil {
+				t.Error(errorExpectedNone)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+)
+
+// IssueRule checks an issue after a create or update request has been applied to it.
+// before is nil for a new issue. A non-nil error rejects the whole request.
+// Rules apply to requests only; system changes such as releasing the issues of a
+// deactivated user are not checked.
+type IssueRule func(before, after *models.Issue) error
+
+// DefaultIssueRules returns the rules every IssueService enforces unless replaced
+func DefaultIssueRules() []IssueRule {
+	return []IssueRule{RequireP0Assignee}
+}
+
+// RequireP0Assignee rejects P0 issues without an assignee, so a P0 issue must be
+// assigned when it is created and cannot be unassigned later
+func RequireP0Assignee(before, after *models.Issue) error {
+	if after.Priority == domain.PriorityP0 && after.User == nil {
+		return domain.ErrP0RequiresAssignee
+	}
+	return nil
+}
+
+// AddRule registers an additional rule checked on every issue create and update
+func (s *IssueService) AddRule(rule IssueRule) {
+	s.rules = append(s.rules, rule)
+}
+
+// checkRules runs every rule against the changed issue, stopping at the first failure
+func (s *IssueService) checkRules(before, after *models.Issue) error {
+	for _, rule := range s.rules {
+		if err := rule(before, after); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateUrgency checks a priority and an optional severity together
+func validateUrgency(priority, severity string) error {
+	var problems []*domain.Error
+	if !domain.IsValidPriority(priority) {
+		problems = append(problems, domain.ErrInvalidPriority)
+	}
+	if severity != "" && !domain.IsValidSeverity(severity) {
+		problems = append(problems, domain.ErrInvalidSeverity)
+	}
+	return domain.JoinValidation(problems...)
+}
+
+// issuePriority returns the issue's priority, treating issues stored before
+// priorities existed as having the default priority
+func issuePriority(issue *models.Issue) string {
+	if issue.Priority == "" {
+		return domain.PriorityDefault
+	}
+	return issue.Priority
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+)
+
+func TestIssuePriorityDefaultsAndValidates(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if issue.Priority != domain.PriorityDefault || issue.Severity != "" {
+		t.Errorf("Expected default priority and no severity, got %s/%s", issue.Priority, issue.Severity)
+	}
+
+	_, err = issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, Priority: "P9", Severity: "huge"})
+	var validationErr *domain.Error
+	if !errors.As(err, &validationErr) || len(validationErr.Fields) != 2 {
+		t.Errorf("Expected priority and severity validation errors, got %v", err)
+	}
+
+	severity := domain.SeverityMajor
+	issue, err = issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Severity: &severity})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	cleared := ""
+	issue, err = issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Severity: &cleared})
+	if err != nil || issue.Severity != "" {
+		t.Errorf("Expected severity to be cleared, got %q (%v)", issue.Severity, err)
+	}
+}
+
+func TestP0IssuesRequireAssignee(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+
+	if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, Priority: domain.PriorityP0}); !errors.Is(err, domain.ErrP0RequiresAssignee) {
+		t.Errorf("Expected P0 to require an assignee on creation, got %v", err)
+	}
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, Priority: domain.PriorityP0, UserID: uintPtr(1)})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{RemoveUser: true}); !errors.Is(err, domain.ErrP0RequiresAssignee) {
+		t.Errorf("Expected P0 assignee removal to be rejected, got %v", err)
+	}
+
+	unassigned, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	p0 := domain.PriorityP0
+	if _, err := issueService.UpdateIssue(unassigned.ID, domain.UpdateIssueRequest{Priority: &p0}); !errors.Is(err, domain.ErrP0RequiresAssignee) {
+		t.Errorf("Expected raising an unassigned issue to P0 to be rejected, got %v", err)
+	}
+}
+
+func TestIssueServiceRunsCustomRules(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	blocked := domain.Conflict("blocked", "blocked")
+	issueService.AddRule(func(before, after *models.Issue) error {
+		if before != nil && after.Title != before.Title {
+			return blocked
+		}
+		return nil
+	})
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	title := "Renamed"
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Title: &title}); !errors.Is(err, blocked) {
+		t.Errorf("Expected custom rule to reject the update, got %v", err)
+	}
+}
+
+func TestListIssuesSortsByPriority(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	for _, priority := range []string{domain.PriorityP3, domain.PriorityP1, ""} {
+		if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, Priority: priority}); err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+	}
+
+	page, err := issueService.ListIssues(domain.IssueListQuery{Sort: domain.SortByPriority})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	var priorities []string
+	for _, issue := range page.Issues {
+		priorities = append(priorities, issue.Priority)
+	}
+	expected := []string{domain.PriorityP1, domain.PriorityP2, domain.PriorityP3}
+	for i := range expected {
+		if priorities[i] != expected[i] {
+			t.Fatalf("Expected priorities %v, got %v", expected, priorities)
+		}
+	}
+}
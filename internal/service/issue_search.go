@@ -0,0 +1,145 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+	"aoroa/internal/search"
+)
+
+// Searchable issue fields and their relevance weights
+const (
+	searchFieldTitle       = "title"
+	searchFieldDescription = "description"
+	searchFieldComments    = "comments"
+)
+
+var searchFieldWeights = map[string]float64{
+	searchFieldTitle:       3,
+	searchFieldDescription: 1,
+	searchFieldComments:    0.5,
+}
+
+// SearchResult is an issue matching a search query with its relevance score
+type SearchResult struct {
+	Issue models.Issue `json:"issue"`
+	Score float64      `json:"score"`
+}
+
+// SearchIssues returns issues matching the query, most relevant first.
+// A limit of zero returns every match. Like ListIssues, only admins see every
+// issue; other callers only find issues of their projects and issues outside
+// any project.
+func (s *IssueService) SearchIssues(query string, limit int, actorID *uint) ([]SearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, domain.ErrSearchQueryRequired
+	}
+	if limit < 0 {
+		return nil, domain.ErrInvalidLimit
+	}
+
+	matches := s.index.Search(query)
+
+	results := []SearchResult{}
+	err := s.store.View(func(tx repository.Tx) error {
+		visible, err := resolveProjectScope(tx, domain.IssueListQuery{ActorID: actorID})
+		if err != nil {
+			return err
+		}
+		issues, err := tx.Issues().List()
+		if err != nil {
+			return err
+		}
+		progress := s.progressByParent(issues)
+		for _, match := range matches {
+			// Hidden issues do not count towards the limit
+			if limit > 0 && len(results) == limit {
+				break
+			}
+			issue, err := tx.Issues().Get(match.ID)
+			if errors.Is(err, repository.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if !visible(issue) {
+				continue
+			}
+			withCurrentUser(tx, issue)
+			if err := withLabels(tx, issue); err != nil {
+				return err
+			}
+			if err := withCommentCount(tx, issue); err != nil {
+				return err
+			}
+			issue.Progress = progress[issue.ID]
+			results = append(results, SearchResult{Issue: *issue, Score: match.Score})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// reindexIssues refreshes the search index entries of the given issues from the store
+func (s *IssueService) reindexIssues(ids ...uint) error {
+	return s.store.View(func(tx repository.Tx) error {
+		for _, id := range ids {
+			if err := indexIssue(tx, s.index, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// rebuildIndex indexes every stored issue
+func (s *IssueService) rebuildIndex() error {
+	return s.store.View(func(tx repository.Tx) error {
+		issues, err := tx.Issues().List()
+		if err != nil {
+			return err
+		}
+		for _, issue := range issues {
+			if err := indexIssue(tx, s.index, issue.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// indexIssue puts the title, description and comments of an issue into the index
+func indexIssue(tx repository.Tx, index *search.Index, id uint) error {
+	issue, err := tx.Issues().Get(id)
+	if errors.Is(err, repository.ErrNotFound) {
+		index.Remove(id)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	comments, err := tx.Comments().ListByIssue(id)
+	if err != nil {
+		return err
+	}
+	bodies := make([]string, len(comments))
+	for i, comment := range comments {
+		bodies[i] = comment.Body
+	}
+
+	index.Put(id, search.Document{
+		searchFieldTitle:       {issue.Title},
+		searchFieldDescription: {issue.Description},
+		searchFieldComments:    bodies,
+	})
+	return nil
+}
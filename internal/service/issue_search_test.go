@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+
+	"aoroa/internal/domain"
+)
+
+func TestSearchIssuesFollowsUpdatesAndComments(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+	commentService := NewCommentService(issueService)
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "결제 오류", Description: "카드 결제가 실패합니다", UserID: uintPtr(1)})
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "Dashboard layout"})
+
+	results, err := issueService.SearchIssues("결제", 0, nil)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(results) != 1 || results[0].Issue.ID != issue.ID {
+		t.Fatalf("Expected issue %d, got %+v", issue.ID, results)
+	}
+
+	title := "Payment failure"
+	issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Title: &title})
+	if results, _ := issueService.SearchIssues("payment", 0, nil); len(results) != 1 {
+		t.Errorf("Expected updated title to be searchable, got %d results", len(results))
+	}
+
+	comment, _ := commentService.CreateComment(issue.ID, 1, domain.CreateCommentRequest{Body: "timeout from the PG gateway"})
+	if results, _ := issueService.SearchIssues(`"pg gateway"`, 0, nil); len(results) != 1 {
+		t.Errorf("Expected comment phrase to be searchable, got %d results", len(results))
+	}
+
+	commentService.DeleteComment(issue.ID, comment.ID, 1)
+	if results, _ := issueService.SearchIssues("gateway", 0, nil); len(results) != 0 {
+		t.Errorf("Expected deleted comment to leave the index, got %d results", len(results))
+	}
+}
+
+func TestSearchIssuesRequiresQuery(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+
+	if _, err := issueService.SearchIssues("   ", 0, nil); err == nil {
+		t.Error(errorExpectedNone)
+	}
+}
+
+func TestSearchIssuesScopesNonAdminsToTheirProjects(t *testing.T) {
+	projectService, issueService := newProjectTestServices(t)
+	if _, err := projectService.CreateProject(domain.CreateProjectRequest{Key: "APP", Name: "App"}, testAdminID); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	for _, key := range []string{"WEB", "APP", ""} {
+		if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: "Checkout crash", ProjectKey: key, ActorID: uintPtr(testAdminID)}); err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		actor    *uint
+		limit    int
+		expected int
+	}{
+		{"admin", uintPtr(testAdminID), 0, 3},
+		{"member", uintPtr(testMemberID), 0, 2},
+		{"outsider", uintPtr(testOutsider), 0, 1},
+		{"anonymous", nil, 0, 1},
+		// Hidden matches do not use up the limit
+		{"outsider with limit", uintPtr(testOutsider), 1, 1},
+	}
+	for _, tt := range tests {
+		results, err := issueService.SearchIssues("checkout", tt.limit, tt.actor)
+		if err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+		if len(results) != tt.expected {
+			t.Errorf("%s: expected %d results, got %d", tt.name, tt.expected, len(results))
+		}
+	}
+}
@@ -2,154 +2,425 @@ package service
 
 import (
 	"errors"
-	"sync"
+	"fmt"
+	"log"
+	"slices"
 	"time"
 
 	"aoroa/internal/domain"
+	"aoroa/internal/events"
 	"aoroa/internal/models"
+	"aoroa/internal/repository"
+	"aoroa/internal/search"
+	"aoroa/internal/workflow"
 )
 
 // IssueService handles issue-related operations
 type IssueService struct {
-	issues      map[uint]*models.Issue
+	store       repository.Store
+	bus         *events.Bus
 	userService *UserService
-	nextID      uint
-	mu          sync.RWMutex
+	workflow    *workflow.Workflow
+	index       *search.Index
+	rules       []IssueRule
 }
 
-// NewIssueService creates a new IssueService
+// NewIssueService creates a new IssueService using the default workflow
 func NewIssueService(userService *UserService) *IssueService {
-	return &IssueService{
-		issues:      make(map[uint]*models.Issue),
+	return NewIssueServiceWithWorkflow(userService, workflow.Default())
+}
+
+// NewIssueServiceWithWorkflow creates a new IssueService sharing the user service's store
+// and enforcing the given workflow
+func NewIssueServiceWithWorkflow(userService *UserService, wf *workflow.Workflow) *IssueService {
+	s := &IssueService{
+		store:       userService.store,
+		bus:         userService.bus,
 		userService: userService,
-		nextID:      1,
+		workflow:    wf,
+		index:       search.NewIndex(searchFieldWeights),
+		rules:       DefaultIssueRules(),
 	}
+
+	// Deactivated users must not stay assigned to open issues
+	userService.onDeactivate(s.releaseAssignedIssues)
+
+	// Keep the search index in step with every committed issue change
+	s.bus.Subscribe(s.indexEvent)
+
+	// Index issues already in the store
+	if err := s.rebuildIndex(); err != nil {
+		log.Printf("Failed to build search index: %v", err)
+	}
+
+	return s
+}
+
+// Workflow returns the workflow enforced by the service
+func (s *IssueService) Workflow() *workflow.Workflow {
+	return s.workflow
 }
 
 // CreateIssue creates a new issue
 func (s *IssueService) CreateIssue(req domain.CreateIssueRequest) (*models.Issue, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	priority := req.Priority
+	if priority == "" {
+		priority = domain.PriorityDefault
+	}
+	if err := validateUrgency(priority, req.Severity); err != nil {
+		return nil, err
+	}
+	if err := validateSchedule(req.StartDate, req.DueDate); err != nil {
+		return nil, err
+	}
 
-	// Validate user if provided
-	var user *models.User
-	if req.UserID != nil {
-		if u, exists := s.userService.GetUser(*req.UserID); exists {
+	var issue *models.Issue
+
+	err := updateAndPublish(s.store, s.bus, func(tx repository.Tx, emit emitFunc) error {
+		// Validate user if provided
+		var user *models.User
+		if req.UserID != nil {
+			u, err := findAssignableUser(tx, *req.UserID)
+			if err != nil {
+				return err
+			}
 			user = u
-		} else {
-			return nil, errors.New("user not found")
 		}
-	}
 
-	// Determine initial status
-	status := domain.StatusPending
-	if user != nil {
-		status = domain.StatusInProgress
-	}
+		actor, err := findActor(tx, req.ActorID)
+		if err != nil {
+			return err
+		}
 
-	now := time.Now()
-	issue := &models.Issue{
-		ID:          s.nextID,
-		Title:       req.Title,
-		Description: req.Description,
-		Status:      status,
-		User:        user,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-	}
+		var project *models.Project
+		if req.ProjectKey != "" {
+			project, err = findProjectByKey(tx, req.ProjectKey)
+			if err != nil {
+				return err
+			}
+			if !canAccessProject(actor, project) {
+				return domain.ErrNotProjectMember
+			}
+			if user != nil && !project.HasMember(user.ID) {
+				return domain.ErrAssigneeNotMember.WithDetail("userId", user.ID)
+			}
+		}
 
-	s.issues[s.nextID] = issue
-	s.nextID++
+		labelIDs, err := resolveLabelNames(tx, req.Labels)
+		if err != nil {
+			return err
+		}
 
-	return issue, nil
-}
+		if req.ParentID != nil {
+			var projectID uint
+			if project != nil {
+				projectID = project.ID
+			}
+			if _, err := s.findParent(tx, *req.ParentID, nil, projectID); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		issue = &models.Issue{
+			Title:       req.Title,
+			Description: req.Description,
+			Status:      s.workflow.Initial,
+			Priority:    priority,
+			Severity:    req.Severity,
+			StartDate:   req.StartDate,
+			DueDate:     req.DueDate,
+			ParentID:    req.ParentID,
+			User:        user,
+			LabelIDs:    labelIDs,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
 
-// GetIssue retrieves an issue by ID
-func (s *IssueService) GetIssue(id uint) (*models.Issue, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+		// Determine initial status: assigning on creation fires the workflow's automation
+		if user != nil {
+			if status, ok := s.workflow.Automate(workflow.TriggerAssigned, issue.Status, false); ok {
+				if err := s.workflow.CheckTransition(issue.Status, status, issue); err != nil {
+					return err
+				}
+				issue.Status = status
+			}
+		}
+
+		if err := s.checkRules(nil, issue); err != nil {
+			return err
+		}
+
+		// Number the issue within its project; the counter shares the issue's transaction
+		if project != nil {
+			project.LastNumber++
+			project.UpdatedAt = now
+			if err := tx.Projects().Update(project); err != nil {
+				return err
+			}
+			issue.ProjectID = project.ID
+			issue.Number = project.LastNumber
+			issue.Key = fmt.Sprintf("%s-%d", project.Key, project.LastNumber)
+		}
 
-	issue, exists := s.issues[id]
-	if !exists {
-		return nil, errors.New("issue not found")
+		if err := tx.Issues().Create(issue); err != nil {
+			return err
+		}
+		if err := recordIssueChanges(tx, nil, issue, actor, now); err != nil {
+			return err
+		}
+		if err := withLabels(tx, issue); err != nil {
+			return err
+		}
+		emit(events.IssueCreated{Issue: *issue, Actor: actor, At: now})
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return issue, nil
 }
 
-// GetIssues retrieves all issues, optionally filtered by status
-func (s *IssueService) GetIssues(status string) ([]models.Issue, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+// GetIssue retrieves an issue by ID. Issues of a project are only available
+// to its members and admins.
+func (s *IssueService) GetIssue(id uint, actorID *uint) (*models.Issue, error) {
+	var issue *models.Issue
 
-	// Validate status if provided
-	if status != "" && !domain.IsValidStatus(status) {
-		return nil, errors.New("invalid status")
-	}
-
-	var result []models.Issue
-	for _, issue := range s.issues {
-		if status == "" || issue.Status == status {
-			result = append(result, *issue)
+	err := s.store.View(func(tx repository.Tx) error {
+		var err error
+		issue, err = findIssue(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkActorIssueAccess(tx, issue, actorID); err != nil {
+			return err
 		}
+		withCurrentUser(tx, issue)
+		if err := withLabels(tx, issue); err != nil {
+			return err
+		}
+		if err := s.withProgress(tx, issue); err != nil {
+			return err
+		}
+		return withCommentCount(tx, issue)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
+	return issue, nil
 }
 
-// UpdateIssue updates an existing issue
-func (s *IssueService) UpdateIssue(id uint, req domain.UpdateIssueRequest) (*models.Issue, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// GetProjectIssue retrieves an issue by its number within a project. The actor
+// must be a member of the project or an admin.
+func (s *IssueService) GetProjectIssue(key string, number uint, actorID uint) (*models.Issue, error) {
+	var issue *models.Issue
 
-	issue, exists := s.issues[id]
-	if !exists {
-		return nil, errors.New("issue not found")
-	}
+	err := s.store.View(func(tx repository.Tx) error {
+		project, err := findProjectByKey(tx, key)
+		if err != nil {
+			return err
+		}
+		actor, err := findActingUser(tx, actorID)
+		if err != nil {
+			return err
+		}
+		if !canAccessProject(actor, project) {
+			return domain.ErrNotProjectMember
+		}
 
-	// Check if issue is in final state
-	if issue.Status == domain.StatusCompleted || issue.Status == domain.StatusCancelled {
-		return nil, errors.New("cannot update completed or cancelled issue")
+		issues, err := tx.Issues().List()
+		if err != nil {
+			return err
+		}
+		for _, candidate := range issues {
+			if candidate.ProjectID == project.ID && candidate.Number == number {
+				issue = withCurrentUser(tx, candidate)
+				if err := withLabels(tx, issue); err != nil {
+					return err
+				}
+				if err := s.withProgress(tx, issue); err != nil {
+					return err
+				}
+				return withCommentCount(tx, issue)
+			}
+		}
+		return domain.ErrIssueNotFound.WithDetail("key", fmt.Sprintf("%s-%d", project.Key, number))
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Validate status if provided
-	if req.Status != nil && !domain.IsValidStatus(*req.Status) {
-		return nil, errors.New("invalid status")
+	return issue, nil
+}
+
+// GetIssues retrieves all issues ordered by ID, optionally filtered by status
+func (s *IssueService) GetIssues(status string) ([]models.Issue, error) {
+	var query domain.IssueListQuery
+	if status != "" {
+		query.Filter.Statuses = []string{status}
 	}
 
-	// Handle user assignment/removal
-	newUser, userChanged, err := s.handleUserChange(issue, req)
+	page, err := s.ListIssues(query)
 	if err != nil {
 		return nil, err
 	}
 
-	// Determine new status based on business rules
-	newStatus := s.determineNewStatus(issue, req, newUser, userChanged)
+	return page.Issues, nil
+}
 
-	// Validate that non-PENDING/CANCELLED statuses have assignees
-	if newUser == nil && newStatus != domain.StatusPending && newStatus != domain.StatusCancelled {
-		return nil, errors.New("cannot set status to " + newStatus + " without assignee")
-	}
+// UpdateIssue updates an existing issue. When req.IfMatch is set, the issue
+// must still be at one of the listed versions.
+func (s *IssueService) UpdateIssue(id uint, req domain.UpdateIssueRequest) (*models.Issue, error) {
+	var issue *models.Issue
+
+	err := updateAndPublish(s.store, s.bus, func(tx repository.Tx, emit emitFunc) error {
+		var err error
+		issue, err = findIssue(tx, id)
+		if err != nil {
+			return err
+		}
+		if req.IfMatch != nil && !slices.Contains(req.IfMatch, issue.Version) {
+			return domain.ErrIssueModified.WithDetail("version", issue.Version)
+		}
+		withCurrentUser(tx, issue)
+
+		// Check if issue is in final state
+		if s.workflow.IsFinal(issue.Status) {
+			return domain.ErrIssueFinalState
+		}
+
+		// Validate status if provided
+		if req.Status != nil && !s.workflow.IsValidState(*req.Status) {
+			return domain.ErrInvalidStatus.WithDetail("status", *req.Status)
+		}
+
+		priority, severity := issuePriority(issue), issue.Severity
+		if req.Priority != nil {
+			priority = *req.Priority
+		}
+		if req.Severity != nil {
+			severity = *req.Severity
+		}
+		if err := validateUrgency(priority, severity); err != nil {
+			return err
+		}
+
+		startDate, dueDate := issue.StartDate, issue.DueDate
+		if req.StartDate != nil || req.RemoveStartDate {
+			startDate = req.StartDate
+		}
+		if req.DueDate != nil || req.RemoveDueDate {
+			dueDate = req.DueDate
+		}
+		if err := validateSchedule(startDate, dueDate); err != nil {
+			return err
+		}
+
+		actor, err := findActor(tx, req.ActorID)
+		if err != nil {
+			return err
+		}
+
+		// Handle user assignment/removal
+		newUser, userChanged, err := s.handleUserChange(tx, issue, req)
+		if err != nil {
+			return err
+		}
+
+		if err := checkProjectUpdate(tx, issue, actor, newUser, userChanged); err != nil {
+			return err
+		}
+
+		// Resolve labels before changing anything so unknown names leave the issue untouched
+		labelIDs := issue.LabelIDs
+		if req.Labels != nil {
+			labelIDs, err = resolveLabelNames(tx, *req.Labels)
+			if err != nil {
+				return err
+			}
+		}
+
+		parentID := issue.ParentID
+		if req.ParentID != nil {
+			if _, err := s.findParent(tx, *req.ParentID, issue, issue.ProjectID); err != nil {
+				return err
+			}
+			parentID = req.ParentID
+		} else if req.RemoveParent {
+			parentID = nil
+		}
+
+		// Determine new status based on workflow automations
+		newStatus := s.determineNewStatus(issue, req, newUser, userChanged)
+
+		// Update issue fields
+		before := *issue
+		s.updateIssueFields(issue, req, newStatus, newUser)
+		issue.LabelIDs = labelIDs
+		issue.Priority = priority
+		issue.Severity = severity
+		issue.StartDate = startDate
+		issue.DueDate = dueDate
+		issue.ParentID = parentID
+
+		// Enforce allowed transitions and their guards against the updated issue
+		if err := s.workflow.CheckTransition(before.Status, issue.Status, issue); err != nil {
+			return err
+		}
+		if err := s.checkRules(&before, issue); err != nil {
+			return err
+		}
+		if s.workflow.IsFinal(issue.Status) && !s.workflow.IsFinal(before.Status) {
+			if err := s.ensureSubTasksClosed(tx, issue); err != nil {
+				return err
+			}
+		}
+		if s.workflow.HasGuard(before.Status, issue.Status, workflow.GuardRequiresUnblocked) {
+			if err := s.ensureUnblocked(tx, issue); err != nil {
+				return err
+			}
+		}
 
-	// Update issue fields
-	s.updateIssueFields(issue, req, newStatus, newUser)
+		if err := tx.Issues().Update(issue); err != nil {
+			return err
+		}
+		if err := recordIssueChanges(tx, &before, issue, actor, issue.UpdatedAt); err != nil {
+			return err
+		}
+		if err := withLabels(tx, issue); err != nil {
+			return err
+		}
+		if err := s.withProgress(tx, issue); err != nil {
+			return err
+		}
+		if err := withCommentCount(tx, issue); err != nil {
+			return err
+		}
+		emit(issueUpdateEvents(&before, issue, actor, issue.UpdatedAt)...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return issue, nil
 }
 
 // handleUserChange handles user assignment/removal logic
-func (s *IssueService) handleUserChange(issue *models.Issue, req domain.UpdateIssueRequest) (*models.User, bool, error) {
+func (s *IssueService) handleUserChange(tx repository.Tx, issue *models.Issue, req domain.UpdateIssueRequest) (*models.User, bool, error) {
 	var newUser *models.User
 	var userChanged bool
 
 	if req.UserID != nil {
 		// User assignment
-		if u, exists := s.userService.GetUser(*req.UserID); exists {
-			newUser = u
-			userChanged = true
-		} else {
-			return nil, false, errors.New("user not found")
+		u, err := findAssignableUser(tx, *req.UserID)
+		if err != nil {
+			return nil, false, err
 		}
+		newUser = u
+		userChanged = true
 	} else if req.RemoveUser {
 		// User removal
 		newUser = nil
@@ -162,21 +433,41 @@ func (s *IssueService) handleUserChange(issue *models.Issue, req domain.UpdateIs
 	return newUser, userChanged, nil
 }
 
-// determineNewStatus determines the new status based on business rules
+// checkProjectUpdate enforces project membership when updating a project's issue:
+// the actor must have access to the project and a new assignee must be a member
+func checkProjectUpdate(tx repository.Tx, issue *models.Issue, actor, newUser *models.User, userChanged bool) error {
+	if issue.ProjectID == 0 {
+		return nil
+	}
+
+	if err := checkIssueAccess(tx, issue, actor); err != nil {
+		return err
+	}
+	project, err := findProject(tx, issue.ProjectID)
+	if err != nil {
+		return err
+	}
+	if userChanged && newUser != nil && !project.HasMember(newUser.ID) {
+		return domain.ErrAssigneeNotMember.WithDetail("userId", newUser.ID)
+	}
+	return nil
+}
+
+// determineNewStatus determines the new status from the request and the workflow's automations
 func (s *IssueService) determineNewStatus(issue *models.Issue, req domain.UpdateIssueRequest, newUser *models.User, userChanged bool) string {
 	newStatus := issue.Status
 	if req.Status != nil {
 		newStatus = *req.Status
 	}
 
-	// Apply business rules
+	// Apply automatic transitions
 	if userChanged {
+		trigger := workflow.TriggerAssigned
 		if newUser == nil {
-			// User removed -> status becomes PENDING
-			newStatus = domain.StatusPending
-		} else if issue.Status == domain.StatusPending && req.Status == nil {
-			// User assigned to PENDING issue without explicit status -> IN_PROGRESS
-			newStatus = domain.StatusInProgress
+			trigger = workflow.TriggerUnassigned
+		}
+		if status, ok := s.workflow.Automate(trigger, issue.Status, req.Status != nil); ok {
+			newStatus = status
 		}
 	}
 
@@ -195,3 +486,92 @@ func (s *IssueService) updateIssueFields(issue *models.Issue, req domain.UpdateI
 	issue.User = newUser
 	issue.UpdatedAt = time.Now()
 }
+
+// reindex refreshes the search index after a committed change. Index failures
+// are logged rather than returned because the change itself has been stored.
+func (s *IssueService) reindex(ids ...uint) {
+	if err := s.reindexIssues(ids...); err != nil {
+		log.Printf("Failed to update search index: %v", err)
+	}
+}
+
+// withCommentCount fills the issue's derived comment count
+func withCommentCount(tx repository.Tx, issue *models.Issue) error {
+	comments, err := tx.Comments().ListByIssue(issue.ID)
+	if err != nil {
+		return err
+	}
+	issue.CommentCount = len(comments)
+	return nil
+}
+
+// releaseAssignedIssues unassigns a user from every open issue, applying the
+// workflow's unassigned automation as if the assignee had been removed by hand
+func (s *IssueService) releaseAssignedIssues(tx repository.Tx, user *models.User, now time.Time, emit emitFunc) error {
+	issues, err := tx.Issues().List()
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		if issue.User == nil || issue.User.ID != user.ID || s.workflow.IsFinal(issue.Status) {
+			continue
+		}
+
+		before := *issue
+		issue.User = nil
+		if status, ok := s.workflow.Automate(workflow.TriggerUnassigned, issue.Status, false); ok {
+			issue.Status = status
+		}
+		issue.UpdatedAt = now
+		if err := tx.Issues().Update(issue); err != nil {
+			return err
+		}
+		if err := recordIssueChanges(tx, &before, issue, nil, now); err != nil {
+			return err
+		}
+		emit(issueUpdateEvents(&before, issue, nil, now)...)
+	}
+	return nil
+}
+
+// findIssue loads an issue within a transaction, translating repository errors
+func findIssue(tx repository.Tx, id uint) (*models.Issue, error) {
+	issue, err := tx.Issues().Get(id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, domain.ErrIssueNotFound
+	}
+	return issue, err
+}
+
+// findUser loads a user within a transaction, translating repository errors
+func findUser(tx repository.Tx, id uint) (*models.User, error) {
+	user, err := tx.Users().Get(id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, domain.ErrUserNotFound
+	}
+	return user, err
+}
+
+// findAssignableUser loads a user that may be assigned to an issue
+func findAssignableUser(tx repository.Tx, id uint) (*models.User, error) {
+	user, err := findUser(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive() {
+		return nil, domain.ErrUserDeactivated
+	}
+	return user, nil
+}
+
+// withCurrentUser replaces the issue's embedded assignee with the latest user record
+func withCurrentUser(tx repository.Tx, issue *models.Issue) *models.Issue {
+	if issue.User == nil {
+		return issue
+	}
+	if user, err := tx.Users().Get(issue.User.ID); err == nil {
+		issue.User = user
+	}
+	return issue
+}
@@ -1,10 +1,12 @@
 package service
 
 import (
+	"errors"
 	"testing"
 
 	"aoroa/internal/domain"
 	"aoroa/internal/models"
+	"aoroa/internal/workflow"
 )
 
 // Test constants to avoid duplication
@@ -110,7 +112,7 @@ func TestIssueServiceGetExistingIssue(t *testing.T) {
 	}
 
 	// Get the issue
-	issue, err := issueService.GetIssue(createdIssue.ID)
+	issue, err := issueService.GetIssue(createdIssue.ID, nil)
 
 	if err != nil {
 		t.Fatalf(errorUnexpected, err)
@@ -129,7 +131,7 @@ func TestIssueServiceGetNonExistingIssue(t *testing.T) {
 	userService := NewUserService()
 	issueService := NewIssueService(userService)
 
-	_, err := issueService.GetIssue(999)
+	_, err := issueService.GetIssue(999, nil)
 
 	if err == nil {
 		t.Fatal(errorExpectedNone)
@@ -237,3 +239,110 @@ func assertIssueCreated(t *testing.T, issue *models.Issue, request domain.Create
 		t.Error("Expected UpdatedAt to be set")
 	}
 }
+
+func TestIssueServiceEnforcesCustomWorkflow(t *testing.T) {
+	wf := &workflow.Workflow{
+		Initial: domain.StatusPending,
+		States: []workflow.State{
+			{Name: domain.StatusPending},
+			{Name: domain.StatusInProgress},
+			{Name: "IN_REVIEW"},
+			{Name: domain.StatusCompleted, Final: true},
+		},
+		Transitions: []workflow.Transition{
+			{To: domain.StatusInProgress, Guards: []string{workflow.GuardRequiresAssignee}},
+			{From: []string{domain.StatusInProgress}, To: "IN_REVIEW"},
+			{From: []string{"IN_REVIEW"}, To: domain.StatusCompleted},
+		},
+		Automations: []workflow.Automation{
+			{On: workflow.TriggerAssigned, From: []string{domain.StatusPending}, To: domain.StatusInProgress},
+		},
+	}
+	issueService := NewIssueServiceWithWorkflow(NewUserService(), wf)
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, UserID: uintPtr(1)})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if issue.Status != domain.StatusInProgress {
+		t.Fatalf("Expected automation to start the issue, got %s", issue.Status)
+	}
+
+	completed := domain.StatusCompleted
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Status: &completed}); err == nil {
+		t.Error("Expected IN_PROGRESS -> COMPLETED to be rejected")
+	}
+
+	review := "IN_REVIEW"
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Status: &review}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	// Without an unassigned automation, removing the assignee keeps the state
+	updated, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{RemoveUser: true})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if updated.Status != review {
+		t.Errorf("Expected status %s, got %s", review, updated.Status)
+	}
+
+	if _, err := issueService.GetIssues("IN_REVIEW"); err != nil {
+		t.Errorf("Expected custom state to be a valid filter, got %v", err)
+	}
+}
+
+func TestIssueServiceRejectsUpdateInFinalState(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	cancelled := domain.StatusCancelled
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Status: &cancelled}); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	title := "reopen"
+	_, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Title: &title})
+	if err == nil || err.Error() != "cannot update issue in final state" {
+		t.Errorf("Expected final state error, got %v", err)
+	}
+}
+
+func TestIssueServiceHonorsIfMatch(t *testing.T) {
+	issueService := NewIssueService(NewUserService())
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	if issue.Version != 1 {
+		t.Fatalf("Expected version 1, got %d", issue.Version)
+	}
+
+	title := "first edit"
+	updated, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Title: &title, IfMatch: []uint{1}})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if updated.Version != 2 {
+		t.Errorf("Expected version 2, got %d", updated.Version)
+	}
+
+	// A second editor still holding version 1 must not overwrite the first edit
+	title = "second edit"
+	_, err = issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Title: &title, IfMatch: []uint{1}})
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) || domainErr.Code != domain.ErrIssueModified.Code || domainErr.Details["version"] != uint(2) {
+		t.Fatalf("Expected issue modified at version 2, got %v", err)
+	}
+	if current, _ := issueService.GetIssue(issue.ID, nil); current.Title != "first edit" || current.Version != 2 {
+		t.Errorf("Expected the first edit to be kept, got %q at version %d", current.Title, current.Version)
+	}
+
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Title: &title, IfMatch: []uint{}}); !errors.Is(err, domain.ErrIssueModified) {
+		t.Errorf("Expected an empty If-Match list to fail, got %v", err)
+	}
+	if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Title: &title, IfMatch: []uint{1, 2}}); err != nil {
+		t.Errorf("Expected any listed version to match, got %v", err)
+	}
+	if _, err := issueService.UpdateIssue(99, domain.UpdateIssueRequest{Title: &title, IfMatch: []uint{1}}); !errors.Is(err, domain.ErrIssueNotFound) {
+		t.Errorf("Expected not found before the version check, got %v", err)
+	}
+}
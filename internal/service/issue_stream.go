@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+const (
+	// DefaultStreamReplaySize is the number of recent issue events kept for resuming streams
+	DefaultStreamReplaySize = 1000
+	// streamSubscriberBuffer is how many events a subscriber may fall behind before it is dropped
+	streamSubscriberBuffer = 64
+)
+
+// IssueStreamEvent is an issue change sent to stream subscribers
+type IssueStreamEvent struct {
+	ID    uint64       // position in the stream, increasing in commit order
+	Event events.Event // events.IssueCreated, events.IssueUpdated or events.IssueOverdue
+
+	// after is the issue after the change; before approximates it before the
+	// change as far as filters are concerned (status and assignee), nil for a new issue
+	after, before *models.Issue
+}
+
+// IssueStream fans issue create, update and overdue events out to live subscribers,
+// such as the Server-Sent Events endpoint. The most recent events are kept in
+// a bounded replay buffer so that a client reconnecting with the ID of the last
+// event it saw receives what it missed.
+type IssueStream struct {
+	issueService *IssueService
+
+	mu          sync.Mutex
+	seq         uint64             // ID of the last event
+	ring        []IssueStreamEvent // event with ID n is at ring[n%len(ring)]
+	subscribers map[*IssueSubscription]bool
+	closed      bool
+}
+
+// IssueSubscription receives the stream events matching its filter
+type IssueSubscription struct {
+	// Replay holds the missed events to send before live ones
+	Replay []IssueStreamEvent
+	// Reset is set when the missed events are no longer available, or the
+	// resume position is unknown; the client should reload the issues it shows
+	Reset bool
+
+	stream  *IssueStream
+	filter  domain.IssueFilter
+	visible func(*models.Issue) bool // project scope of the subscriber
+	events  chan IssueStreamEvent
+}
+
+// NewIssueStream creates a stream keeping replaySize events for resuming
+// (at least one) and subscribes it to the issue events of the services
+func NewIssueStream(issueService *IssueService, replaySize int) *IssueStream {
+	if replaySize < 1 {
+		replaySize = 1
+	}
+
+	s := &IssueStream{
+		issueService: issueService,
+		ring:         make([]IssueStreamEvent, replaySize),
+		subscribers:  make(map[*IssueSubscription]bool),
+	}
+	// Synchronous, so stream IDs follow commit order
+	issueService.bus.Subscribe(s.handleEvent)
+	return s
+}
+
+// Subscribe starts a subscription to the events of issues matching the status
+// and assignee criteria of filter; other criteria are ignored. Like the issue
+// list, the subscription only covers the projects the actor may see, as of
+// subscribing. lastEventID is the ID of the last event the client received,
+// empty for a new stream.
+func (s *IssueStream) Subscribe(filter domain.IssueFilter, actorID *uint, lastEventID string) (*IssueSubscription, error) {
+	filter = domain.IssueFilter{Statuses: filter.Statuses, AssigneeIDs: filter.AssigneeIDs, Unassigned: filter.Unassigned}
+	if err := s.issueService.validateIssueFilter(filter); err != nil {
+		return nil, err
+	}
+
+	var visible func(*models.Issue) bool
+	err := s.issueService.store.View(func(tx repository.Tx) error {
+		var err error
+		visible, err = resolveProjectScope(tx, domain.IssueListQuery{ActorID: actorID})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &IssueSubscription{
+		stream:  s,
+		filter:  filter,
+		visible: visible,
+		events:  make(chan IssueStreamEvent, streamSubscriberBuffer),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		close(sub.events)
+		return sub, nil
+	}
+
+	if lastEventID != "" {
+		sub.Replay, sub.Reset = s.replay(sub, lastEventID)
+	}
+	s.subscribers[sub] = true
+	return sub, nil
+}
+
+// Events returns the live events of the subscription. The channel is closed
+// when the subscriber falls too far behind or the stream stops; a client then
+// reconnects and resumes from the last event it received.
+func (sub *IssueSubscription) Events() <-chan IssueStreamEvent {
+	return sub.events
+}
+
+// Close ends the subscription
+func (sub *IssueSubscription) Close() {
+	s := sub.stream
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.drop(sub)
+}
+
+// Start implements the server's background service interface
+func (s *IssueStream) Start() error {
+	return nil
+}
+
+// Stop ends every subscription so that open streams finish and the server can shut down
+func (s *IssueStream) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	for sub := range s.subscribers {
+		s.drop(sub)
+	}
+	return nil
+}
+
+// handleEvent records an issue event and passes it on to matching subscribers.
+// It runs under the bus lock, so it never blocks on a subscriber.
+func (s *IssueStream) handleEvent(event events.Event) {
+	var after, before *models.Issue
+	switch e := event.(type) {
+	case events.IssueCreated:
+		after = &e.Issue
+	case events.IssueUpdated:
+		after = &e.Issue
+		before = previousIssueState(e)
+	case events.IssueOverdue:
+		after = &e.Issue
+	default:
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seq++
+	streamEvent := IssueStreamEvent{ID: s.seq, Event: event, after: after, before: before}
+	s.ring[s.seq%uint64(len(s.ring))] = streamEvent
+
+	for sub := range s.subscribers {
+		if !sub.matches(streamEvent) {
+			continue
+		}
+		select {
+		case sub.events <- streamEvent:
+		default:
+			// Too slow; the client resumes from the replay buffer when it reconnects
+			s.drop(sub)
+		}
+	}
+}
+
+// replay returns the buffered events after lastEventID matching the
+// subscription, reporting whether some of them are no longer available
+func (s *IssueStream) replay(sub *IssueSubscription, lastEventID string) ([]IssueStreamEvent, bool) {
+	last, err := strconv.ParseUint(lastEventID, 10, 64)
+	if err != nil || last > s.seq {
+		// Not an ID of this stream, e.g. issued before a restart
+		return nil, true
+	}
+
+	oldest := uint64(1)
+	if s.seq > uint64(len(s.ring)) {
+		oldest = s.seq - uint64(len(s.ring)) + 1
+	}
+	if last+1 < oldest {
+		return nil, true
+	}
+
+	var missed []IssueStreamEvent
+	for id := last + 1; id <= s.seq; id++ {
+		if event := s.ring[id%uint64(len(s.ring))]; sub.matches(event) {
+			missed = append(missed, event)
+		}
+	}
+	return missed, false
+}
+
+// drop removes a subscriber and closes its channel. The caller holds s.mu.
+func (s *IssueStream) drop(sub *IssueSubscription) {
+	if s.subscribers[sub] {
+		delete(s.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// matches reports whether the issue is visible to the subscriber and matches
+// the subscription filter before or after the change, so subscribers also
+// learn about issues leaving their view
+func (sub *IssueSubscription) matches(event IssueStreamEvent) bool {
+	if !sub.visible(event.after) {
+		// Issues do not move between projects, so this holds before the change as well
+		return false
+	}
+	if matchesIssueFilter(event.after, sub.filter) {
+		return true
+	}
+	return event.before != nil && matchesIssueFilter(event.before, sub.filter)
+}
+
+// previousIssueState rebuilds the filtered fields of an updated issue as they were before the change
+func previousIssueState(e events.IssueUpdated) *models.Issue {
+	before := e.Issue
+	for _, change := range e.Changes {
+		switch change.Field {
+		case HistoryFieldStatus:
+			if change.OldValue != nil {
+				before.Status = *change.OldValue
+			}
+		case HistoryFieldUser:
+			before.User = nil
+			if change.OldValue != nil {
+				if id, err := strconv.ParseUint(*change.OldValue, 10, 32); err == nil {
+					before.User = &models.User{ID: uint(id)}
+				}
+			}
+		}
+	}
+	return &before
+}
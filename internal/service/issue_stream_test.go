@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
+)
+
+// receiveAll returns the events buffered on a subscription without waiting
+func receiveAll(sub *IssueSubscription) []IssueStreamEvent {
+	var received []IssueStreamEvent
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return received
+			}
+			received = append(received, event)
+		default:
+			return received
+		}
+	}
+}
+
+func TestIssueStreamFiltersByStatusAndAssignee(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	stream := NewIssueStream(issueService, 10)
+
+	pending, err := stream.Subscribe(domain.IssueFilter{Statuses: []string{domain.StatusPending}}, nil, "")
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	mine, _ := stream.Subscribe(domain.IssueFilter{AssigneeIDs: []uint{2}}, nil, "")
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	// Assigning moves the issue out of PENDING and into the assignee's view
+	issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{UserID: uintPtr(2)})
+	// Now outside the status filter before and after
+	completed := domain.StatusCompleted
+	issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Status: &completed})
+	userService.CreateUser(domain.CreateUserRequest{Name: "New", Email: "new@example.com"})
+
+	received := receiveAll(pending)
+	if len(received) != 2 || received[0].Event.Topic() != events.TopicIssueCreated || received[1].Event.Topic() != events.TopicIssueUpdated {
+		t.Fatalf("Expected creation and the update leaving PENDING, got %+v", received)
+	}
+	if received[0].ID >= received[1].ID {
+		t.Errorf("Expected increasing event IDs, got %d and %d", received[0].ID, received[1].ID)
+	}
+	if received := receiveAll(mine); len(received) != 2 {
+		t.Errorf("Expected the assignment and completion, got %d events", len(received))
+	}
+
+	if _, err := stream.Subscribe(domain.IssueFilter{Statuses: []string{"UNKNOWN"}}, nil, ""); err == nil {
+		t.Error("Expected unknown status to be rejected")
+	}
+}
+
+func TestIssueStreamReplaysMissedEvents(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	stream := NewIssueStream(issueService, 3)
+
+	for i := 0; i < 3; i++ {
+		issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	}
+
+	sub, _ := stream.Subscribe(domain.IssueFilter{}, nil, "1")
+	if sub.Reset || len(sub.Replay) != 2 || sub.Replay[0].ID != 2 || sub.Replay[1].ID != 3 {
+		t.Fatalf("Expected events 2 and 3 replayed, got %+v (reset %v)", sub.Replay, sub.Reset)
+	}
+	sub.Close()
+
+	// Event 1 has been evicted by event 4
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	if sub, _ := stream.Subscribe(domain.IssueFilter{}, nil, "0"); !sub.Reset || len(sub.Replay) != 0 {
+		t.Errorf("Expected a reset for evicted events, got %+v", sub)
+	}
+	if sub, _ := stream.Subscribe(domain.IssueFilter{}, nil, "1"); sub.Reset || len(sub.Replay) != 3 {
+		t.Errorf("Expected events 2 to 4 replayed, got %+v", sub)
+	}
+	if sub, _ := stream.Subscribe(domain.IssueFilter{}, nil, "4"); sub.Reset || len(sub.Replay) != 0 {
+		t.Errorf("Expected nothing to replay for an up-to-date client, got %+v", sub)
+	}
+	for _, lastEventID := range []string{"99", "abc"} {
+		if sub, _ := stream.Subscribe(domain.IssueFilter{}, nil, lastEventID); !sub.Reset {
+			t.Errorf("Expected a reset for unknown event ID %q", lastEventID)
+		}
+	}
+}
+
+func TestIssueStreamDropsSlowSubscribers(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	stream := NewIssueStream(issueService, DefaultStreamReplaySize)
+
+	slow, _ := stream.Subscribe(domain.IssueFilter{}, nil, "")
+	for i := 0; i <= streamSubscriberBuffer; i++ {
+		issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	}
+
+	received := receiveAll(slow)
+	if len(received) != streamSubscriberBuffer {
+		t.Fatalf("Expected %d buffered events, got %d", streamSubscriberBuffer, len(received))
+	}
+	if _, ok := <-slow.Events(); ok {
+		t.Fatal("Expected the slow subscription to be closed")
+	}
+
+	// The dropped client resumes where it stopped
+	last := strconv.FormatUint(received[len(received)-1].ID, 10)
+	resumed, _ := stream.Subscribe(domain.IssueFilter{}, nil, last)
+	if resumed.Reset || len(resumed.Replay) != 1 {
+		t.Errorf("Expected the missed event replayed, got %+v", resumed.Replay)
+	}
+}
+
+func TestIssueStreamStopEndsSubscriptions(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	stream := NewIssueStream(issueService, DefaultStreamReplaySize)
+
+	sub, _ := stream.Subscribe(domain.IssueFilter{}, nil, "")
+	if err := stream.Stop(context.Background()); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, ok := <-sub.Events(); ok {
+		t.Error("Expected the subscription to be closed")
+	}
+	sub.Close()
+
+	late, _ := stream.Subscribe(domain.IssueFilter{}, nil, "")
+	if _, ok := <-late.Events(); ok {
+		t.Error("Expected subscriptions after stop to be closed")
+	}
+}
+
+func TestIssueStreamScopesEventsToProjects(t *testing.T) {
+	_, issueService := newProjectTestServices(t)
+	stream := NewIssueStream(issueService, DefaultStreamReplaySize)
+
+	anonymous, _ := stream.Subscribe(domain.IssueFilter{}, nil, "")
+	outsider, _ := stream.Subscribe(domain.IssueFilter{}, uintPtr(testOutsider), "")
+	member, _ := stream.Subscribe(domain.IssueFilter{}, uintPtr(testMemberID), "")
+
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ProjectKey: "WEB", ActorID: uintPtr(testMemberID)})
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+
+	for name, sub := range map[string]*IssueSubscription{"anonymous": anonymous, "outsider": outsider} {
+		if received := receiveAll(sub); len(received) != 1 || received[0].ID != 2 {
+			t.Errorf("Expected %s to receive only the issue outside projects, got %+v", name, received)
+		}
+	}
+	if received := receiveAll(member); len(received) != 2 {
+		t.Errorf("Expected the member to receive both issues, got %d events", len(received))
+	}
+
+	// Replay is scoped the same way
+	if sub, _ := stream.Subscribe(domain.IssueFilter{}, nil, "0"); sub.Reset || len(sub.Replay) != 1 || sub.Replay[0].ID != 2 {
+		t.Errorf("Expected only event 2 replayed, got %+v", sub.Replay)
+	}
+	if _, err := stream.Subscribe(domain.IssueFilter{}, uintPtr(99), ""); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Errorf("Expected unknown actors to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,297 @@
+package service
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+// defaultLabelColor is used for labels created without a color
+const defaultLabelColor = "#ededed"
+
+// labelColorPattern matches hex colors such as "#d73a4a"
+var labelColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// LabelService handles label-related operations
+type LabelService struct {
+	store repository.Store
+	bus   *events.Bus
+}
+
+// NewLabelService creates a new LabelService sharing the user service's store and event bus
+func NewLabelService(userService *UserService) *LabelService {
+	return &LabelService{
+		store: userService.store,
+		bus:   userService.bus,
+	}
+}
+
+// CreateLabel creates a new label. Label names are unique regardless of case.
+// Only admins manage labels.
+func (s *LabelService) CreateLabel(req domain.CreateLabelRequest, actorID *uint) (*models.Label, error) {
+	color := req.Color
+	if strings.TrimSpace(color) == "" {
+		color = defaultLabelColor
+	}
+	name, color, err := validateLabelFields(req.Name, color)
+	if err != nil {
+		return nil, err
+	}
+
+	var label *models.Label
+	err = s.store.Update(func(tx repository.Tx) error {
+		if _, err := findAdminActor(tx, actorID); err != nil {
+			return err
+		}
+		if err := ensureLabelNameAvailable(tx, name, 0); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		label = &models.Label{
+			Name:        name,
+			Color:       color,
+			Description: req.Description,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		return tx.Labels().Create(label)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return label, nil
+}
+
+// GetLabel retrieves a label by ID
+func (s *LabelService) GetLabel(id uint) (*models.Label, error) {
+	var label *models.Label
+	err := s.store.View(func(tx repository.Tx) error {
+		var err error
+		label, err = findLabel(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+// GetAllLabels retrieves all labels ordered by ID
+func (s *LabelService) GetAllLabels() ([]*models.Label, error) {
+	var labels []*models.Label
+	err := s.store.View(func(tx repository.Tx) error {
+		var err error
+		labels, err = tx.Labels().List()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// UpdateLabel updates the name, color and/or description of a label.
+// Issues refer to labels by ID, so a renamed label stays attached. Only admins
+// manage labels.
+func (s *LabelService) UpdateLabel(id uint, req domain.UpdateLabelRequest, actorID *uint) (*models.Label, error) {
+	var label *models.Label
+	err := s.store.Update(func(tx repository.Tx) error {
+		if _, err := findAdminActor(tx, actorID); err != nil {
+			return err
+		}
+
+		var err error
+		label, err = findLabel(tx, id)
+		if err != nil {
+			return err
+		}
+
+		name, color := label.Name, label.Color
+		if req.Name != nil {
+			name = *req.Name
+		}
+		if req.Color != nil {
+			color = *req.Color
+		}
+		name, color, err = validateLabelFields(name, color)
+		if err != nil {
+			return err
+		}
+		if err := ensureLabelNameAvailable(tx, name, label.ID); err != nil {
+			return err
+		}
+
+		label.Name = name
+		label.Color = color
+		if req.Description != nil {
+			label.Description = *req.Description
+		}
+		label.UpdatedAt = time.Now()
+		return tx.Labels().Update(label)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+// DeleteLabel deletes a label and detaches it from every issue carrying it,
+// recording the change by the acting admin in each issue's history. Only
+// admins manage labels.
+func (s *LabelService) DeleteLabel(id uint, actorID *uint) error {
+	return updateAndPublish(s.store, s.bus, func(tx repository.Tx, emit emitFunc) error {
+		actor, err := findAdminActor(tx, actorID)
+		if err != nil {
+			return err
+		}
+		if _, err := findLabel(tx, id); err != nil {
+			return err
+		}
+
+		issues, err := tx.Issues().List()
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		for _, issue := range issues {
+			if !containsUint(issue.LabelIDs, id) {
+				continue
+			}
+
+			before := *issue
+			labelIDs := make([]uint, 0, len(issue.LabelIDs)-1)
+			for _, labelID := range issue.LabelIDs {
+				if labelID != id {
+					labelIDs = append(labelIDs, labelID)
+				}
+			}
+			issue.LabelIDs = labelIDs
+			issue.UpdatedAt = now
+			if err := tx.Issues().Update(issue); err != nil {
+				return err
+			}
+			if err := recordIssueChanges(tx, &before, issue, actor, now); err != nil {
+				return err
+			}
+			emit(issueUpdateEvents(&before, issue, actor, now)...)
+		}
+
+		return tx.Labels().Delete(id)
+	})
+}
+
+// validateLabelFields normalizes and validates a label's name and color
+func validateLabelFields(name, color string) (string, string, error) {
+	name = strings.TrimSpace(name)
+	color = strings.ToLower(strings.TrimSpace(color))
+
+	var problems []*domain.Error
+	if name == "" {
+		problems = append(problems, domain.ErrLabelNameRequired)
+	} else if strings.Contains(name, ",") {
+		// Commas separate label names in list filters
+		problems = append(problems, domain.ErrInvalidLabelName)
+	}
+	if !labelColorPattern.MatchString(color) {
+		problems = append(problems, domain.ErrInvalidLabelColor)
+	}
+	if err := domain.JoinValidation(problems...); err != nil {
+		return "", "", err
+	}
+
+	return name, color, nil
+}
+
+// ensureLabelNameAvailable checks that no other label already uses the name (case-insensitive)
+func ensureLabelNameAvailable(tx repository.Tx, name string, exceptID uint) error {
+	labels, err := tx.Labels().List()
+	if err != nil {
+		return err
+	}
+	for _, label := range labels {
+		if label.ID != exceptID && strings.EqualFold(label.Name, name) {
+			return domain.ErrLabelNameInUse.WithDetail("name", label.Name)
+		}
+	}
+	return nil
+}
+
+// findLabel loads a label within a transaction, translating repository errors
+func findLabel(tx repository.Tx, id uint) (*models.Label, error) {
+	label, err := tx.Labels().Get(id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, domain.ErrLabelNotFound
+	}
+	return label, err
+}
+
+// resolveLabelNames maps label names (case-insensitive) to label IDs in ascending order
+func resolveLabelNames(tx repository.Tx, names []string) ([]uint, error) {
+	labels, err := tx.Labels().List()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		found := false
+		for _, label := range labels {
+			if strings.EqualFold(label.Name, name) {
+				if !containsUint(ids, label.ID) {
+					ids = append(ids, label.ID)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, domain.ErrLabelNotFound.WithDetail("name", name)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// labelsByID loads every label keyed by ID
+func labelsByID(tx repository.Tx) (map[uint]*models.Label, error) {
+	labels, err := tx.Labels().List()
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[uint]*models.Label, len(labels))
+	for _, label := range labels {
+		result[label.ID] = label
+	}
+	return result, nil
+}
+
+// attachLabels fills the issue's derived labels from its label IDs
+func attachLabels(issue *models.Issue, labels map[uint]*models.Label) {
+	issue.Labels = make([]*models.Label, 0, len(issue.LabelIDs))
+	for _, id := range issue.LabelIDs {
+		if label, ok := labels[id]; ok {
+			issue.Labels = append(issue.Labels, label)
+		}
+	}
+}
+
+// withLabels fills the issue's derived labels
+func withLabels(tx repository.Tx, issue *models.Issue) error {
+	labels, err := labelsByID(tx)
+	if err != nil {
+		return err
+	}
+	attachLabels(issue, labels)
+	return nil
+}
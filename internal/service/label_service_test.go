@@ -0,0 +1,159 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"aoroa/internal/domain"
+)
+
+func newLabelTestServices(t *testing.T, names ...string) (*LabelService, *IssueService) {
+	t.Helper()
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	labelService := NewLabelService(userService)
+
+	for _, name := range names {
+		if _, err := labelService.CreateLabel(domain.CreateLabelRequest{Name: name}, uintPtr(testAdminID)); err != nil {
+			t.Fatalf("Failed to create label %s: %v", name, err)
+		}
+	}
+	return labelService, issueService
+}
+
+func TestLabelServiceValidatesLabels(t *testing.T) {
+	labelService, _ := newLabelTestServices(t, "bug")
+
+	if _, err := labelService.CreateLabel(domain.CreateLabelRequest{Name: "BUG"}, uintPtr(testAdminID)); !errors.Is(err, domain.ErrLabelNameInUse) {
+		t.Errorf("Expected label name in use error, got %v", err)
+	}
+	if _, err := labelService.CreateLabel(domain.CreateLabelRequest{Name: "ui", Color: "red"}, uintPtr(testAdminID)); !errors.Is(err, domain.ErrInvalidLabelColor) {
+		t.Errorf("Expected invalid color error, got %v", err)
+	}
+
+	label, err := labelService.CreateLabel(domain.CreateLabelRequest{Name: " ui ", Color: "#D73A4A"}, uintPtr(testAdminID))
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if label.Name != "ui" || label.Color != "#d73a4a" {
+		t.Errorf("Expected normalized label, got %+v", label)
+	}
+}
+
+func TestIssueLabelsFilterWithAllAndAny(t *testing.T) {
+	_, issueService := newLabelTestServices(t, "bug", "ui", "backend")
+
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "Button misaligned", Labels: []string{"bug", "UI"}})
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "Timeout", Labels: []string{"bug", "backend"}})
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: "New theme", Labels: []string{"ui"}})
+
+	tests := []struct {
+		name   string
+		filter domain.IssueFilter
+		want   int
+	}{
+		{"All by default", domain.IssueFilter{Labels: []string{"bug", "ui"}}, 1},
+		{"Any", domain.IssueFilter{Labels: []string{"bug", "ui"}, LabelMatch: domain.LabelMatchAny}, 3},
+		{"Single label", domain.IssueFilter{Labels: []string{"backend"}}, 1},
+		{"Unknown label", domain.IssueFilter{Labels: []string{"docs"}}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := issueService.ListIssues(domain.IssueListQuery{Filter: tt.filter})
+			if err != nil {
+				t.Fatalf(errorUnexpected, err)
+			}
+			if page.Total != tt.want {
+				t.Errorf("Expected %d issues, got %d", tt.want, page.Total)
+			}
+		})
+	}
+
+	_, err := issueService.ListIssues(domain.IssueListQuery{Filter: domain.IssueFilter{Labels: []string{"bug"}, LabelMatch: "some"}})
+	if !errors.Is(err, domain.ErrInvalidLabelMatch) {
+		t.Errorf("Expected invalid label match error, got %v", err)
+	}
+}
+
+func TestIssueLabelsReplaceAndUnknownNames(t *testing.T) {
+	_, issueService := newLabelTestServices(t, "bug", "ui")
+
+	if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, Labels: []string{"docs"}}); !errors.Is(err, domain.ErrLabelNotFound) {
+		t.Errorf("Expected label not found error, got %v", err)
+	}
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, Labels: []string{"ui", "bug", "bug"}})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(issue.Labels) != 2 || issue.Labels[0].Name != "bug" || issue.Labels[1].Name != "ui" {
+		t.Errorf("Expected labels bug and ui, got %v", issue.LabelIDs)
+	}
+
+	labels := []string{"ui"}
+	issue, err = issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Labels: &labels})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(issue.Labels) != 1 || issue.Labels[0].Name != "ui" {
+		t.Errorf("Expected only label ui, got %v", issue.LabelIDs)
+	}
+
+	history, _ := issueService.GetIssueHistory(issue.ID, nil)
+	last := history[len(history)-1]
+	assertHistoryEntry(t, last, HistoryFieldLabels, "1,2", "2")
+}
+
+func TestLabelServiceDeleteDetachesFromIssues(t *testing.T) {
+	labelService, issueService := newLabelTestServices(t, "bug", "ui")
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, Labels: []string{"bug", "ui"}})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	if err := labelService.DeleteLabel(1, uintPtr(testAdminID)); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	issue, err = issueService.GetIssue(issue.ID, nil)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(issue.LabelIDs) != 1 || issue.LabelIDs[0] != 2 {
+		t.Errorf("Expected only label 2 to remain, got %v", issue.LabelIDs)
+	}
+	if _, err := labelService.GetLabel(1); !errors.Is(err, domain.ErrLabelNotFound) {
+		t.Errorf("Expected label not found error, got %v", err)
+	}
+}
+
+func TestLabelChangesRequireAdmin(t *testing.T) {
+	labelService, issueService := newLabelTestServices(t, "bug")
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, Labels: []string{"bug"}})
+	name := "defect"
+
+	for _, actorID := range []*uint{nil, uintPtr(testMemberID)} {
+		if _, err := labelService.CreateLabel(domain.CreateLabelRequest{Name: "ui"}, actorID); !errors.Is(err, domain.ErrAdminRequired) {
+			t.Errorf("Expected admin required error creating a label, got %v", err)
+		}
+		if _, err := labelService.UpdateLabel(1, domain.UpdateLabelRequest{Name: &name}, actorID); !errors.Is(err, domain.ErrAdminRequired) {
+			t.Errorf("Expected admin required error updating a label, got %v", err)
+		}
+		if err := labelService.DeleteLabel(1, actorID); !errors.Is(err, domain.ErrAdminRequired) {
+			t.Errorf("Expected admin required error deleting a label, got %v", err)
+		}
+	}
+	if current, _ := issueService.GetIssue(issue.ID, nil); len(current.LabelIDs) != 1 || current.Version != issue.Version {
+		t.Errorf("Expected rejected deletions to leave the issue alone, got %+v", current)
+	}
+
+	// The admin deleting a label is recorded as the actor of the detached issues
+	if err := labelService.DeleteLabel(1, uintPtr(testAdminID)); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	history, _ := issueService.GetIssueHistory(issue.ID, nil)
+	if last := history[len(history)-1]; last.Actor == nil || last.Actor.ID != testAdminID {
+		t.Errorf("Expected the admin as actor, got %+v", last.Actor)
+	}
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+// projectKeyPattern matches valid project keys such as "WEB" or "APP2"
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+// ProjectService handles project-related operations
+type ProjectService struct {
+	store repository.Store
+}
+
+// NewProjectService creates a new ProjectService sharing the user service's store
+func NewProjectService(userService *UserService) *ProjectService {
+	return &ProjectService{
+		store: userService.store,
+	}
+}
+
+// CreateProject creates a new project. Only admins may create projects;
+// the creating admin is not added as a member implicitly.
+func (s *ProjectService) CreateProject(req domain.CreateProjectRequest, actorID uint) (*models.Project, error) {
+	key := strings.ToUpper(strings.TrimSpace(req.Key))
+	name := strings.TrimSpace(req.Name)
+
+	var problems []*domain.Error
+	if !projectKeyPattern.MatchString(key) {
+		problems = append(problems, domain.ErrInvalidProjectKey)
+	}
+	if name == "" {
+		problems = append(problems, domain.ErrProjectNameRequired)
+	}
+	if err := domain.JoinValidation(problems...); err != nil {
+		return nil, err
+	}
+
+	var project *models.Project
+	err := s.store.Update(func(tx repository.Tx) error {
+		if _, err := findAdmin(tx, actorID); err != nil {
+			return err
+		}
+
+		projects, err := tx.Projects().List()
+		if err != nil {
+			return err
+		}
+		for _, existing := range projects {
+			if existing.Key == key {
+				return domain.ErrProjectKeyInUse.WithDetail("key", key)
+			}
+		}
+
+		members := make([]uint, 0, len(req.MemberIDs))
+		for _, id := range req.MemberIDs {
+			if containsUint(members, id) {
+				continue
+			}
+			if _, err := findAssignableUser(tx, id); err != nil {
+				return err
+			}
+			members = append(members, id)
+		}
+
+		now := time.Now()
+		project = &models.Project{
+			Key:         key,
+			Name:        name,
+			Description: req.Description,
+			MemberIDs:   members,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		return tx.Projects().Create(project)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// GetProject retrieves a project by key (case-insensitive)
+func (s *ProjectService) GetProject(key string) (*models.Project, error) {
+	var project *models.Project
+	err := s.store.View(func(tx repository.Tx) error {
+		var err error
+		project, err = findProjectByKey(tx, key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// GetAllProjects retrieves all projects ordered by ID
+func (s *ProjectService) GetAllProjects() ([]*models.Project, error) {
+	var projects []*models.Project
+	err := s.store.View(func(tx repository.Tx) error {
+		var err error
+		projects, err = tx.Projects().List()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// UpdateProject changes a project's name or description. Only admins may update projects.
+func (s *ProjectService) UpdateProject(key string, req domain.UpdateProjectRequest, actorID uint) (*models.Project, error) {
+	var project *models.Project
+	err := s.store.Update(func(tx repository.Tx) error {
+		if _, err := findAdmin(tx, actorID); err != nil {
+			return err
+		}
+
+		var err error
+		project, err = findProjectByKey(tx, key)
+		if err != nil {
+			return err
+		}
+
+		if req.Name != nil {
+			name := strings.TrimSpace(*req.Name)
+			if name == "" {
+				return domain.ErrProjectNameRequired
+			}
+			project.Name = name
+		}
+		if req.Description != nil {
+			project.Description = *req.Description
+		}
+		project.UpdatedAt = time.Now()
+		return tx.Projects().Update(project)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// AddMember adds an active user to a project. Only admins may manage members.
+func (s *ProjectService) AddMember(key string, userID, actorID uint) (*models.Project, error) {
+	var project *models.Project
+	err := s.store.Update(func(tx repository.Tx) error {
+		if _, err := findAdmin(tx, actorID); err != nil {
+			return err
+		}
+
+		var err error
+		project, err = findProjectByKey(tx, key)
+		if err != nil {
+			return err
+		}
+		if project.HasMember(userID) {
+			return domain.ErrAlreadyProjectMember
+		}
+		if _, err := findAssignableUser(tx, userID); err != nil {
+			return err
+		}
+
+		// Build a new slice: records returned by the store share slices with the stored row
+		members := make([]uint, 0, len(project.MemberIDs)+1)
+		project.MemberIDs = append(append(members, project.MemberIDs...), userID)
+		project.UpdatedAt = time.Now()
+		return tx.Projects().Update(project)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// RemoveMember removes a user from a project. Issues already assigned to the
+// user keep their assignee. Only admins may manage members.
+func (s *ProjectService) RemoveMember(key string, userID, actorID uint) (*models.Project, error) {
+	var project *models.Project
+	err := s.store.Update(func(tx repository.Tx) error {
+		if _, err := findAdmin(tx, actorID); err != nil {
+			return err
+		}
+
+		var err error
+		project, err = findProjectByKey(tx, key)
+		if err != nil {
+			return err
+		}
+		if !project.HasMember(userID) {
+			return domain.ErrNotProjectMember.WithDetail("userId", userID)
+		}
+
+		members := make([]uint, 0, len(project.MemberIDs))
+		for _, id := range project.MemberIDs {
+			if id != userID {
+				members = append(members, id)
+			}
+		}
+		project.MemberIDs = members
+		project.UpdatedAt = time.Now()
+		return tx.Projects().Update(project)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// findProjectByKey loads a project by its key, ignoring case
+func findProjectByKey(tx repository.Tx, key string) (*models.Project, error) {
+	key = strings.ToUpper(strings.TrimSpace(key))
+	projects, err := tx.Projects().List()
+	if err != nil {
+		return nil, err
+	}
+	for _, project := range projects {
+		if project.Key == key {
+			return project, nil
+		}
+	}
+	return nil, domain.ErrProjectNotFound.WithDetail("key", key)
+}
+
+// findProject loads a project by ID within a transaction, translating repository errors
+func findProject(tx repository.Tx, id uint) (*models.Project, error) {
+	project, err := tx.Projects().Get(id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, domain.ErrProjectNotFound
+	}
+	return project, err
+}
+
+// findAdmin loads the acting user and requires the admin role
+func findAdmin(tx repository.Tx, actorID uint) (*models.User, error) {
+	actor, err := findActingUser(tx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin(actor) {
+		return nil, domain.ErrAdminRequired
+	}
+	return actor, nil
+}
+
+// findAdminActor is findAdmin for an optional acting user; anonymous callers are no admins
+func findAdminActor(tx repository.Tx, actorID *uint) (*models.User, error) {
+	if actorID == nil {
+		return nil, domain.ErrAdminRequired
+	}
+	return findAdmin(tx, *actorID)
+}
+
+// isAdmin reports whether the user has the admin role
+func isAdmin(user *models.User) bool {
+	return user != nil && user.Role == domain.UserRoleAdmin
+}
+
+// canAccessProject reports whether the user may work with the project's issues
+func canAccessProject(user *models.User, project *models.Project) bool {
+	return isAdmin(user) || (user != nil && project.HasMember(user.ID))
+}
+
+// checkIssueAccess requires the actor to have access to the issue's project.
+// Issues outside any project are open to everyone; a nil actor is no member.
+func checkIssueAccess(tx repository.Tx, issue *models.Issue, actor *models.User) error {
+	if issue.ProjectID == 0 {
+		return nil
+	}
+
+	project, err := findProject(tx, issue.ProjectID)
+	if err != nil {
+		return err
+	}
+	if !canAccessProject(actor, project) {
+		return domain.ErrNotProjectMember
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"aoroa/internal/domain"
+)
+
+// Seeded users: 1 is an admin, 2 and 3 are members
+const (
+	testAdminID  uint = 1
+	testMemberID uint = 2
+	testOutsider uint = 3
+)
+
+func newProjectTestServices(t *testing.T) (*ProjectService, *IssueService) {
+	t.Helper()
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	projectService := NewProjectService(userService)
+
+	_, err := projectService.CreateProject(domain.CreateProjectRequest{
+		Key:       "web",
+		Name:      "Web",
+		MemberIDs: []uint{testMemberID},
+	}, testAdminID)
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	return projectService, issueService
+}
+
+func TestProjectServiceRequiresAdmin(t *testing.T) {
+	projectService, _ := newProjectTestServices(t)
+
+	_, err := projectService.CreateProject(domain.CreateProjectRequest{Key: "APP", Name: "App"}, testMemberID)
+	if !errors.Is(err, domain.ErrAdminRequired) {
+		t.Errorf("Expected admin required error, got %v", err)
+	}
+
+	_, err = projectService.CreateProject(domain.CreateProjectRequest{Key: "WEB", Name: "Other"}, testAdminID)
+	if !errors.Is(err, domain.ErrProjectKeyInUse) {
+		t.Errorf("Expected key in use error, got %v", err)
+	}
+
+	_, err = projectService.CreateProject(domain.CreateProjectRequest{Key: "1X", Name: ""}, testAdminID)
+	var validationErr *domain.Error
+	if !errors.As(err, &validationErr) || len(validationErr.Fields) != 2 {
+		t.Errorf("Expected key and name validation errors, got %v", err)
+	}
+}
+
+func TestProjectIssuesAreNumberedPerProject(t *testing.T) {
+	projectService, issueService := newProjectTestServices(t)
+	if _, err := projectService.CreateProject(domain.CreateProjectRequest{Key: "APP", Name: "App"}, testAdminID); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+
+	var keys []string
+	for _, key := range []string{"WEB", "APP", "web"} {
+		issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ProjectKey: key, ActorID: uintPtr(testAdminID)})
+		if err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+		keys = append(keys, issue.Key)
+	}
+
+	expected := []string{"WEB-1", "APP-1", "WEB-2"}
+	for i := range expected {
+		if keys[i] != expected[i] {
+			t.Errorf("Expected keys %v, got %v", expected, keys)
+			break
+		}
+	}
+
+	issue, err := issueService.GetProjectIssue("WEB", 2, testMemberID)
+	if err != nil || issue.Key != "WEB-2" {
+		t.Errorf("Expected WEB-2, got %v (%v)", issue, err)
+	}
+}
+
+func TestProjectIssuesRequireMembership(t *testing.T) {
+	_, issueService := newProjectTestServices(t)
+
+	_, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ProjectKey: "WEB", ActorID: uintPtr(testOutsider)})
+	if !errors.Is(err, domain.ErrNotProjectMember) {
+		t.Errorf("Expected not project member error, got %v", err)
+	}
+
+	_, err = issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ProjectKey: "WEB", UserID: uintPtr(testOutsider), ActorID: uintPtr(testMemberID)})
+	if !errors.Is(err, domain.ErrAssigneeNotMember) {
+		t.Errorf("Expected assignee not member error, got %v", err)
+	}
+
+	issue, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ProjectKey: "WEB", ActorID: uintPtr(testMemberID)})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	_, err = issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{UserID: uintPtr(testOutsider), ActorID: uintPtr(testMemberID)})
+	if !errors.Is(err, domain.ErrAssigneeNotMember) {
+		t.Errorf("Expected assignee not member error on update, got %v", err)
+	}
+
+	// Outsiders and anonymous callers can neither read nor change the issue
+	title := "changed"
+	for _, actorID := range []*uint{uintPtr(testOutsider), nil} {
+		if _, err := issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{Title: &title, ActorID: actorID}); !errors.Is(err, domain.ErrNotProjectMember) {
+			t.Errorf("Expected not project member error on update, got %v", err)
+		}
+		if _, err := issueService.GetIssue(issue.ID, actorID); !errors.Is(err, domain.ErrNotProjectMember) {
+			t.Errorf("Expected not project member error on read, got %v", err)
+		}
+		if _, err := issueService.GetIssueHistory(issue.ID, actorID); !errors.Is(err, domain.ErrNotProjectMember) {
+			t.Errorf("Expected not project member error on history, got %v", err)
+		}
+		if _, err := issueService.GetIssueChildren(issue.ID, actorID); !errors.Is(err, domain.ErrNotProjectMember) {
+			t.Errorf("Expected not project member error on children, got %v", err)
+		}
+	}
+	if _, err := issueService.GetIssue(issue.ID, uintPtr(testMemberID)); err != nil {
+		t.Errorf("Expected members to read the issue, got %v", err)
+	}
+}
+
+func TestListIssuesScopesNonAdminsToTheirProjects(t *testing.T) {
+	projectService, issueService := newProjectTestServices(t)
+	if _, err := projectService.CreateProject(domain.CreateProjectRequest{Key: "APP", Name: "App"}, testAdminID); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	for _, key := range []string{"WEB", "APP", ""} {
+		if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ProjectKey: key, ActorID: uintPtr(testAdminID)}); err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+	}
+
+	tests := []struct {
+		actor    uint
+		projects []string
+		expected int
+	}{
+		{testAdminID, nil, 3},
+		{testAdminID, []string{"app"}, 1},
+		{testMemberID, nil, 2},
+		{testOutsider, nil, 1},
+	}
+	for _, tt := range tests {
+		query := domain.IssueListQuery{ActorID: uintPtr(tt.actor), Filter: domain.IssueFilter{ProjectKeys: tt.projects}}
+		page, err := issueService.ListIssues(query)
+		if err != nil {
+			t.Fatalf(errorUnexpected, err)
+		}
+		if page.Total != tt.expected {
+			t.Errorf("Actor %d, projects %v: expected %d issues, got %d", tt.actor, tt.projects, tt.expected, page.Total)
+		}
+	}
+
+	_, err := issueService.ListIssues(domain.IssueListQuery{ActorID: uintPtr(testMemberID), Filter: domain.IssueFilter{ProjectKeys: []string{"APP"}}})
+	if !errors.Is(err, domain.ErrNotProjectMember) {
+		t.Errorf("Expected not project member error, got %v", err)
+	}
+
+	// Anonymous callers only see issues outside any project
+	if page, err := issueService.ListIssues(domain.IssueListQuery{}); err != nil || page.Total != 1 {
+		t.Errorf("Expected 1 issue for an anonymous caller, got %v (%v)", page, err)
+	}
+	_, err = issueService.ListIssues(domain.IssueListQuery{Filter: domain.IssueFilter{ProjectKeys: []string{"WEB"}}})
+	if !errors.Is(err, domain.ErrNotProjectMember) {
+		t.Errorf("Expected not project member error for an anonymous caller, got %v", err)
+	}
+}
+
+func TestProjectServiceManagesMembers(t *testing.T) {
+	projectService, _ := newProjectTestServices(t)
+
+	project, err := projectService.AddMember("WEB", testOutsider, testAdminID)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if !project.HasMember(testOutsider) {
+		t.Errorf("Expected user %d to be a member, got %v", testOutsider, project.MemberIDs)
+	}
+
+	if _, err := projectService.AddMember("WEB", testOutsider, testAdminID); !errors.Is(err, domain.ErrAlreadyProjectMember) {
+		t.Errorf("Expected already member error, got %v", err)
+	}
+
+	project, err = projectService.RemoveMember("WEB", testMemberID, testAdminID)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if project.HasMember(testMemberID) {
+		t.Errorf("Expected user %d to be removed, got %v", testMemberID, project.MemberIDs)
+	}
+}
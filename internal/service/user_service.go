@@ -1,78 +1,277 @@
 package service
 
 import (
-	"sync"
+	"net/mail"
+	"strings"
+	"time"
 
 	"aoroa/internal/domain"
+	"aoroa/internal/events"
 	"aoroa/internal/models"
+	"aoroa/internal/repository"
 )
 
+// deactivationHook runs inside the deactivation transaction of a user
+type deactivationHook func(tx repository.Tx, user *models.User, at time.Time, emit emitFunc) error
+
 // UserService handles user-related operations
 type UserService struct {
-	users map[uint]*models.User
-	mu    sync.RWMutex
+	store           repository.Store
+	bus             *events.Bus
+	deactivateHooks []deactivationHook
 }
 
-// NewUserService creates a new UserService with predefined users
+// NewUserService creates a new UserService with predefined users backed by an in-memory store
 func NewUserService() *UserService {
-	service := &UserService{
-		users: make(map[uint]*models.User),
+	service, err := NewUserServiceWithStore(repository.NewMemoryStore())
+	if err != nil {
+		// Seeding an empty in-memory store cannot fail
+		panic(err)
 	}
+	return service
+}
 
-	// Initialize with required users
-	predefinedUsers := []*models.User{
-		{ID: 1, Name: "김개발", Email: "kim@example.com"},
-		{ID: 2, Name: "이디자인", Email: "lee@example.com"},
-		{ID: 3, Name: "박기획", Email: "park@example.com"},
+// NewUserServiceWithStore creates a new UserService on top of the given store.
+// The default users are seeded only when the store holds no users yet.
+func NewUserServiceWithStore(store repository.Store) (*UserService, error) {
+	return NewUserServiceWithSeeds(store, domain.DefaultSeedUsers())
+}
+
+// NewUserServiceWithSeeds creates a new UserService on top of the given store,
+// seeding the given users (with IDs starting at 1) only when the store holds no users yet.
+func NewUserServiceWithSeeds(store repository.Store, seeds []domain.CreateUserRequest) (*UserService, error) {
+	service := &UserService{
+		store: store,
+		bus:   events.NewBus(),
 	}
 
-	for _, user := range predefinedUsers {
-		service.users[user.ID] = user
+	err := store.Update(func(tx repository.Tx) error {
+		existing, err := tx.Users().List()
+		if err != nil || len(existing) > 0 {
+			return err
+		}
+		for i, seed := range seeds {
+			name, email, err := validateUserFields(seed.Name, seed.Email)
+			if err != nil {
+				return err
+			}
+			if err := ensureEmailAvailable(tx, email, 0); err != nil {
+				return err
+			}
+			role := seed.Role
+			if role == "" {
+				role = domain.UserRoleMember
+			}
+			if !domain.IsValidUserRole(role) {
+				return domain.Validation("invalid_role", "invalid role").WithDetail("role", role)
+			}
+			user := &models.User{ID: uint(i + 1), Name: name, Email: email, Role: role}
+			if err := tx.Users().Create(user); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return service
+	return service, nil
+}
+
+// Events returns the bus on which the services publish their domain events
+func (s *UserService) Events() *events.Bus {
+	return s.bus
 }
 
 // GetUser retrieves a user by ID
 func (s *UserService) GetUser(id uint) (*models.User, bool) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	user, exists := s.users[id]
-	return user, exists
+	var user *models.User
+	err := s.store.View(func(tx repository.Tx) error {
+		var err error
+		user, err = tx.Users().Get(id)
+		return err
+	})
+	if err != nil {
+		return nil, false
+	}
+	return user, true
 }
 
 // CreateUser creates a new user
 func (s *UserService) CreateUser(req domain.CreateUserRequest) (*models.User, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	name, email, err := validateUserFields(req.Name, req.Email)
+	if err != nil {
+		return nil, err
+	}
 
-	// Find next available ID
-	nextID := uint(1)
-	for id := range s.users {
-		if id >= nextID {
-			nextID = id + 1
+	user := &models.User{
+		Name:  name,
+		Email: email,
+		Role:  domain.UserRoleMember,
+	}
+
+	// The repository assigns the next available ID
+	err = updateAndPublish(s.store, s.bus, func(tx repository.Tx, emit emitFunc) error {
+		if err := ensureEmailAvailable(tx, email, 0); err != nil {
+			return err
+		}
+		if err := tx.Users().Create(user); err != nil {
+			return err
 		}
+		emit(events.UserCreated{User: *user, At: time.Now()})
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	user := &models.User{
-		ID:    nextID,
-		Name:  req.Name,
-		Email: req.Email,
+	return user, nil
+}
+
+// UpdateUser updates the name and/or email of an existing user. Only the user
+// themselves or an admin may do so.
+func (s *UserService) UpdateUser(id, actorID uint, req domain.UpdateUserRequest) (*models.User, error) {
+	var user *models.User
+
+	err := s.store.Update(func(tx repository.Tx) error {
+		if err := checkSelfOrAdmin(tx, id, actorID); err != nil {
+			return err
+		}
+
+		var err error
+		user, err = findUser(tx, id)
+		if err != nil {
+			return err
+		}
+
+		name, email := user.Name, user.Email
+		if req.Name != nil {
+			name = *req.Name
+		}
+		if req.Email != nil {
+			email = *req.Email
+		}
+
+		name, email, err = validateUserFields(name, email)
+		if err != nil {
+			return err
+		}
+		if err := ensureEmailAvailable(tx, email, user.ID); err != nil {
+			return err
+		}
+
+		user.Name = name
+		user.Email = email
+		return tx.Users().Update(user)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	s.users[nextID] = user
 	return user, nil
 }
 
+// DeactivateUser deactivates a user. Open issues assigned to the user are
+// unassigned exactly as if the assignee had been removed (returning to PENDING
+// in the default workflow); issues in final states keep the user for the record.
+// Only the user themselves or an admin may deactivate a user.
+func (s *UserService) DeactivateUser(id, actorID uint) (*models.User, error) {
+	var user *models.User
+
+	err := updateAndPublish(s.store, s.bus, func(tx repository.Tx, emit emitFunc) error {
+		if err := checkSelfOrAdmin(tx, id, actorID); err != nil {
+			return err
+		}
+
+		var err error
+		user, err = findUser(tx, id)
+		if err != nil {
+			return err
+		}
+		if !user.IsActive() {
+			return domain.ErrUserAlreadyDeactivated
+		}
+
+		now := time.Now()
+		user.DeactivatedAt = &now
+		if err := tx.Users().Update(user); err != nil {
+			return err
+		}
+
+		for _, hook := range s.deactivateHooks {
+			if err := hook(tx, user, now, emit); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// checkSelfOrAdmin requires the acting user to be the user with the given ID or an admin
+func checkSelfOrAdmin(tx repository.Tx, id, actorID uint) error {
+	actor, err := findActingUser(tx, actorID)
+	if err != nil {
+		return err
+	}
+	if actor.ID != id && !isAdmin(actor) {
+		return domain.ErrNotSelfOrAdmin
+	}
+	return nil
+}
+
 // GetAllUsers returns all users
 func (s *UserService) GetAllUsers() []*models.User {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	var users []*models.User
+	s.store.View(func(tx repository.Tx) error {
+		var err error
+		users, err = tx.Users().List()
+		return err
+	})
+	return users
+}
 
-	users := make([]*models.User, 0, len(s.users))
-	for _, user := range s.users {
-		users = append(users, user)
+// onDeactivate registers a hook run in the same transaction that deactivates a user
+func (s *UserService) onDeactivate(hook deactivationHook) {
+	s.deactivateHooks = append(s.deactivateHooks, hook)
+}
+
+// validateUserFields normalizes and validates a user's name and email
+func validateUserFields(name, email string) (string, string, error) {
+	name = strings.TrimSpace(name)
+	email = strings.TrimSpace(email)
+
+	var problems []*domain.Error
+	if name == "" {
+		problems = append(problems, domain.ErrNameRequired)
 	}
-	return users
+	if email == "" {
+		problems = append(problems, domain.ErrEmailRequired)
+	} else if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		problems = append(problems, domain.ErrInvalidEmail)
+	}
+	if err := domain.JoinValidation(problems...); err != nil {
+		return "", "", err
+	}
+
+	return name, email, nil
+}
+
+// ensureEmailAvailable checks that no other user already uses the email (case-insensitive)
+func ensureEmailAvailable(tx repository.Tx, email string, exceptID uint) error {
+	users, err := tx.Users().List()
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		if user.ID != exceptID && strings.EqualFold(user.Email, email) {
+			return domain.ErrEmailInUse
+		}
+	}
+	return nil
 }
@@ -1,8 +1,10 @@
 package service
 
 import (
+	"errors"
 	"testing"
 
+	"aoroa/internal/domain"
 	"aoroa/internal/models"
 )
 
@@ -96,3 +98,131 @@ func TestUserServiceGetAllUsers(t *testing.T) {
 		}
 	}
 }
+
+func TestUserServiceCreateUserRejectsDuplicateEmail(t *testing.T) {
+	service := NewUserService()
+
+	_, err := service.CreateUser(domain.CreateUserRequest{Name: "Another Kim", Email: "KIM@example.com"})
+	if err == nil {
+		t.Fatal(errorExpectedNone)
+	}
+	if err.Error() != "email already in use" {
+		t.Errorf("Expected 'email already in use', got '%s'", err.Error())
+	}
+}
+
+func TestUserServiceCreateUserValidation(t *testing.T) {
+	service := NewUserService()
+
+	tests := []struct {
+		name     string
+		request  domain.CreateUserRequest
+		expected string
+	}{
+		{"Missing name", domain.CreateUserRequest{Email: "new@example.com"}, "name is required"},
+		{"Missing email", domain.CreateUserRequest{Name: "New"}, "email is required"},
+		{"Malformed email", domain.CreateUserRequest{Name: "New", Email: "not-an-email"}, "invalid email"},
+		{"Missing name and malformed email", domain.CreateUserRequest{Email: "not-an-email"}, "name is required; invalid email"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CreateUser(tt.request)
+			if err == nil || err.Error() != tt.expected {
+				t.Errorf("CreateUser() error = %v, want %s", err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUserServiceUpdateUser(t *testing.T) {
+	service := NewUserService()
+
+	newName := "김개발자"
+	user, err := service.UpdateUser(1, 1, domain.UpdateUserRequest{Name: &newName})
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if user.Name != newName || user.Email != "kim@example.com" {
+		t.Errorf("Unexpected user after update: %+v", user)
+	}
+
+	takenEmail := "lee@example.com"
+	if _, err := service.UpdateUser(1, 1, domain.UpdateUserRequest{Email: &takenEmail}); err == nil {
+		t.Error("Expected error when taking another user's email")
+	}
+}
+
+func TestUserChangesRequireSelfOrAdmin(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	projectService := NewProjectService(userService)
+	name := "Renamed"
+
+	if _, err := userService.UpdateUser(testOutsider, testMemberID, domain.UpdateUserRequest{Name: &name}); !errors.Is(err, domain.ErrNotSelfOrAdmin) {
+		t.Errorf("Expected not self or admin error, got %v", err)
+	}
+	if _, err := userService.DeactivateUser(testOutsider, testMemberID); !errors.Is(err, domain.ErrNotSelfOrAdmin) {
+		t.Errorf("Expected not self or admin error, got %v", err)
+	}
+	if _, err := userService.UpdateUser(testMemberID, testMemberID, domain.UpdateUserRequest{Name: &name}); err != nil {
+		t.Errorf(errorUnexpected, err)
+	}
+	if _, err := userService.UpdateUser(testOutsider, testAdminID, domain.UpdateUserRequest{Name: &name}); err != nil {
+		t.Errorf(errorUnexpected, err)
+	}
+
+	// Deactivated users can no longer act, as admins or otherwise
+	if _, err := userService.DeactivateUser(testMemberID, testMemberID); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := userService.UpdateUser(testMemberID, testMemberID, domain.UpdateUserRequest{Name: &name}); !errors.Is(err, domain.ErrActorDeactivated) {
+		t.Errorf("Expected actor deactivated error, got %v", err)
+	}
+	if _, err := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle, ActorID: uintPtr(testMemberID)}); !errors.Is(err, domain.ErrActorDeactivated) {
+		t.Errorf("Expected actor deactivated error, got %v", err)
+	}
+	if _, err := userService.DeactivateUser(testAdminID, testAdminID); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := projectService.CreateProject(domain.CreateProjectRequest{Key: "WEB", Name: "Web"}, testAdminID); !errors.Is(err, domain.ErrActorDeactivated) {
+		t.Errorf("Expected actor deactivated error, got %v", err)
+	}
+}
+
+func TestUserServiceDeactivateUserReleasesOpenIssues(t *testing.T) {
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+
+	open, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "open", UserID: uintPtr(1)})
+	done, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: "done", UserID: uintPtr(1)})
+	completed := domain.StatusCompleted
+	if _, err := issueService.UpdateIssue(done.ID, domain.UpdateIssueRequest{Status: &completed}); err != nil {
+		t.Fatalf("Failed to complete issue: %v", err)
+	}
+
+	user, err := userService.DeactivateUser(1, testAdminID)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if user.IsActive() {
+		t.Error("Expected user to be deactivated")
+	}
+
+	released, _ := issueService.GetIssue(open.ID, nil)
+	if released.User != nil || released.Status != domain.StatusPending {
+		t.Errorf("Expected open issue to be unassigned and PENDING, got user=%v status=%s", released.User, released.Status)
+	}
+
+	kept, _ := issueService.GetIssue(done.ID, nil)
+	if kept.User == nil || kept.User.ID != 1 {
+		t.Error("Expected completed issue to keep its assignee")
+	}
+
+	if _, err := issueService.UpdateIssue(open.ID, domain.UpdateIssueRequest{UserID: uintPtr(1)}); err == nil {
+		t.Error("Expected error when assigning a deactivated user")
+	}
+	if _, err := userService.DeactivateUser(1, testAdminID); err == nil {
+		t.Error("Expected error when deactivating twice")
+	}
+}
@@ -0,0 +1,604 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
+	"aoroa/internal/models"
+	"aoroa/internal/repository"
+)
+
+// Headers sent with every webhook delivery
+const (
+	WebhookEventHeader     = "X-Aoroa-Event"
+	WebhookDeliveryHeader  = "X-Aoroa-Delivery"
+	WebhookSignatureHeader = "X-Aoroa-Signature"
+)
+
+const (
+	// webhookWorkers is the number of workers posting first attempts; events of one issue share a worker
+	webhookWorkers = 4
+	// webhookQueueSize is the number of first attempts waiting per worker; further ones are left to RetryDue
+	webhookQueueSize = 256
+	// maxWebhookBackoff caps the delay between two attempts
+	maxWebhookBackoff = time.Hour
+	// maxWebhookResponse is how much of a response body is read before the connection is released
+	maxWebhookResponse = 64 << 10
+)
+
+// WebhookOptions tunes webhook delivery
+type WebhookOptions struct {
+	MaxAttempts int           // attempts before a delivery is dead-lettered
+	Timeout     time.Duration // limit for a single attempt
+	Backoff     time.Duration // delay before the first retry, doubled for each further retry
+	Client      *http.Client  // nil uses a client limited by Timeout
+}
+
+// DefaultWebhookOptions returns the delivery settings used when nothing is configured
+func DefaultWebhookOptions() WebhookOptions {
+	return WebhookOptions{
+		MaxAttempts: 6,
+		Timeout:     10 * time.Second,
+		Backoff:     30 * time.Second,
+	}
+}
+
+// webhookPayload is the JSON body posted for an event. It is built once per
+// event, so every attempt of a delivery carries the same signed bytes.
+type webhookPayload struct {
+	Event string       `json:"event"`
+	Data  events.Event `json:"data"`
+}
+
+// WebhookService manages webhook subscriptions and delivers domain events to them.
+// An asynchronous bus subscriber only records the deliveries of an event; their
+// first attempts are posted by the service's own workers, so a slow receiver
+// never holds up the bus. Failed deliveries are retried by RetryDue with
+// exponential backoff until they succeed or run out of attempts, which leaves
+// them dead-lettered in the delivery log.
+type WebhookService struct {
+	store  repository.Store
+	opts   WebhookOptions
+	client *http.Client
+
+	queues []chan uint     // first attempts waiting for a worker
+	ctx    context.Context // attempts of the workers, cancelled when stopping times out
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex
+	inflight map[uint]bool // deliveries being attempted right now
+	closed   bool
+}
+
+// NewWebhookService creates a WebhookService sharing the user service's store
+// and subscribes it to the domain events of the services
+func NewWebhookService(userService *UserService, opts WebhookOptions) *WebhookService {
+	if opts.MaxAttempts < 1 {
+		opts.MaxAttempts = 1
+	}
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: opts.Timeout}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &WebhookService{
+		store:    userService.store,
+		opts:     opts,
+		client:   client,
+		queues:   make([]chan uint, webhookWorkers),
+		ctx:      ctx,
+		cancel:   cancel,
+		inflight: make(map[uint]bool),
+	}
+	for i := range s.queues {
+		s.queues[i] = make(chan uint, webhookQueueSize)
+		s.wg.Add(1)
+		go s.work(s.queues[i])
+	}
+	userService.bus.SubscribeAsync(s.handleEvent, webhookWorkers)
+	return s
+}
+
+// Start implements the server's background service interface. The workers
+// start with the service, so there is nothing left to start.
+func (s *WebhookService) Start() error {
+	return nil
+}
+
+// Stop stops taking first attempts and waits until the queued ones are done
+// or ctx is done. Attempts still running then are aborted; they stay pending
+// and are retried by RetryDue after a restart. Stop the bus first, so that
+// no more deliveries are recorded.
+func (s *WebhookService) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		for _, queue := range s.queues {
+			close(queue)
+		}
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.cancel()
+		return ctx.Err()
+	}
+}
+
+// CreateWebhook subscribes a URL to events. Only admins manage webhooks.
+func (s *WebhookService) CreateWebhook(req domain.CreateWebhookRequest, actorID uint) (*models.Webhook, error) {
+	rawURL, topics, err := validateWebhookFields(req.URL, req.Events, req.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook *models.Webhook
+	err = s.store.Update(func(tx repository.Tx) error {
+		if _, err := findAdmin(tx, actorID); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		webhook = &models.Webhook{
+			URL:       rawURL,
+			Events:    topics,
+			Secret:    req.Secret,
+			Active:    req.Active == nil || *req.Active,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		return tx.Webhooks().Create(webhook)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return redactWebhook(webhook), nil
+}
+
+// GetWebhook retrieves a webhook by ID
+func (s *WebhookService) GetWebhook(id, actorID uint) (*models.Webhook, error) {
+	var webhook *models.Webhook
+	err := s.store.View(func(tx repository.Tx) error {
+		if _, err := findAdmin(tx, actorID); err != nil {
+			return err
+		}
+		var err error
+		webhook, err = findWebhook(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return redactWebhook(webhook), nil
+}
+
+// GetWebhooks returns every webhook ordered by ID
+func (s *WebhookService) GetWebhooks(actorID uint) ([]*models.Webhook, error) {
+	var webhooks []*models.Webhook
+	err := s.store.View(func(tx repository.Tx) error {
+		if _, err := findAdmin(tx, actorID); err != nil {
+			return err
+		}
+		var err error
+		webhooks, err = tx.Webhooks().List()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, webhook := range webhooks {
+		redactWebhook(webhook)
+	}
+	return webhooks, nil
+}
+
+// UpdateWebhook changes the URL, event filter, secret or active flag of a webhook
+func (s *WebhookService) UpdateWebhook(id uint, req domain.UpdateWebhookRequest, actorID uint) (*models.Webhook, error) {
+	var webhook *models.Webhook
+
+	err := s.store.Update(func(tx repository.Tx) error {
+		if _, err := findAdmin(tx, actorID); err != nil {
+			return err
+		}
+		var err error
+		webhook, err = findWebhook(tx, id)
+		if err != nil {
+			return err
+		}
+
+		rawURL, topics, secret := webhook.URL, webhook.Events, webhook.Secret
+		if req.URL != nil {
+			rawURL = *req.URL
+		}
+		if req.Events != nil {
+			topics = *req.Events
+		}
+		if req.Secret != nil {
+			secret = *req.Secret
+		}
+		rawURL, topics, err = validateWebhookFields(rawURL, topics, secret)
+		if err != nil {
+			return err
+		}
+
+		webhook.URL = rawURL
+		webhook.Events = topics
+		webhook.Secret = secret
+		if req.Active != nil {
+			webhook.Active = *req.Active
+		}
+		webhook.UpdatedAt = time.Now()
+		return tx.Webhooks().Update(webhook)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return redactWebhook(webhook), nil
+}
+
+// DeleteWebhook removes a webhook together with its delivery log
+func (s *WebhookService) DeleteWebhook(id, actorID uint) error {
+	return s.store.Update(func(tx repository.Tx) error {
+		if _, err := findAdmin(tx, actorID); err != nil {
+			return err
+		}
+		if _, err := findWebhook(tx, id); err != nil {
+			return err
+		}
+
+		deliveries, err := tx.Deliveries().ListByWebhook(id)
+		if err != nil {
+			return err
+		}
+		for _, delivery := range deliveries {
+			if err := tx.Deliveries().Delete(delivery.ID); err != nil {
+				return err
+			}
+		}
+		return tx.Webhooks().Delete(id)
+	})
+}
+
+// GetDeliveries returns the delivery log of a webhook, newest first
+func (s *WebhookService) GetDeliveries(id, actorID uint) ([]*models.WebhookDelivery, error) {
+	var deliveries []*models.WebhookDelivery
+	err := s.store.View(func(tx repository.Tx) error {
+		if _, err := findAdmin(tx, actorID); err != nil {
+			return err
+		}
+		if _, err := findWebhook(tx, id); err != nil {
+			return err
+		}
+		var err error
+		deliveries, err = tx.Deliveries().ListByWebhook(id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool { return deliveries[i].ID > deliveries[j].ID })
+	return deliveries, nil
+}
+
+// RetryDue attempts every pending delivery whose retry time has come by now.
+// It is run periodically by the server's scheduler.
+func (s *WebhookService) RetryDue(ctx context.Context, now time.Time) error {
+	var due []uint
+	err := s.store.View(func(tx repository.Tx) error {
+		deliveries, err := tx.Deliveries().List()
+		if err != nil {
+			return err
+		}
+		for _, delivery := range deliveries {
+			if delivery.Status == domain.DeliveryPending && delivery.NextAttemptAt != nil && !delivery.NextAttemptAt.After(now) {
+				due = append(due, delivery.ID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, id := range due {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if s.claim(id) {
+			s.attempt(ctx, id)
+		}
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the signature header value for body: the
+// hex-encoded HMAC-SHA256 of the body keyed by the webhook secret
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// handleEvent records a delivery for every active webhook subscribed to the
+// event and queues its first attempt. It never waits for a receiver.
+func (s *WebhookService) handleEvent(event events.Event) {
+	payload, err := json.Marshal(webhookPayload{Event: event.Topic(), Data: event})
+	if err != nil {
+		log.Printf("webhooks: failed to encode %s: %v", event.Topic(), err)
+		return
+	}
+
+	now := time.Now()
+	var created []uint
+	err = s.store.Update(func(tx repository.Tx) error {
+		webhooks, err := tx.Webhooks().List()
+		if err != nil {
+			return err
+		}
+		for _, webhook := range webhooks {
+			if !webhook.Active || !subscribesTo(webhook, event.Topic()) {
+				continue
+			}
+			delivery := &models.WebhookDelivery{
+				WebhookID:     webhook.ID,
+				Event:         event.Topic(),
+				Payload:       payload,
+				Status:        domain.DeliveryPending,
+				NextAttemptAt: &now,
+				CreatedAt:     now,
+			}
+			if err := tx.Deliveries().Create(delivery); err != nil {
+				return err
+			}
+			created = append(created, delivery.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("webhooks: failed to record deliveries of %s: %v", event.Topic(), err)
+		return
+	}
+
+	// RetryDue only sees committed deliveries, so one it claimed first is
+	// already being attempted
+	for _, id := range created {
+		if s.claim(id) {
+			s.enqueue(event.Key(), id)
+		}
+	}
+}
+
+// enqueue hands a claimed delivery to the worker of its event key. When that
+// worker is too far behind, the delivery is released and stays pending, so
+// RetryDue attempts it instead.
+func (s *WebhookService) enqueue(key string, id uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		select {
+		case s.queues[events.Partition(key, len(s.queues))] <- id:
+			return
+		default:
+			log.Printf("webhooks: worker queue full, delivery %d left for retry", id)
+		}
+	}
+	delete(s.inflight, id)
+}
+
+// work attempts the deliveries queued for one worker until the queue is closed
+func (s *WebhookService) work(queue <-chan uint) {
+	defer s.wg.Done()
+	for id := range queue {
+		s.attempt(s.ctx, id)
+	}
+}
+
+// attempt posts a claimed delivery once and records the outcome, scheduling a
+// retry or dead-lettering the delivery on failure. It releases the claim. An
+// attempt aborted because ctx is done is not recorded.
+func (s *WebhookService) attempt(ctx context.Context, id uint) {
+	defer s.release(id)
+
+	var delivery *models.WebhookDelivery
+	var webhook *models.Webhook
+	err := s.store.View(func(tx repository.Tx) error {
+		var err error
+		delivery, err = tx.Deliveries().Get(id)
+		if err != nil {
+			return err
+		}
+		webhook, err = tx.Webhooks().Get(delivery.WebhookID)
+		return err
+	})
+	if errors.Is(err, repository.ErrNotFound) {
+		// The webhook was deleted together with its deliveries
+		return
+	}
+	if err != nil {
+		log.Printf("webhooks: failed to load delivery %d: %v", id, err)
+		return
+	}
+
+	now := time.Now()
+	code, sendErr := 0, errors.New("webhook is inactive")
+	if webhook.Active {
+		code, sendErr = s.send(ctx, webhook, delivery)
+	}
+	if ctx.Err() != nil {
+		// Shutting down; the delivery is attempted again later
+		return
+	}
+
+	delivery.Attempts++
+	delivery.LastAttemptAt = &now
+	delivery.ResponseCode = code
+	delivery.NextAttemptAt = nil
+	switch {
+	case sendErr == nil:
+		delivery.Status = domain.DeliveryDelivered
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+	case delivery.Attempts >= s.opts.MaxAttempts || !webhook.Active:
+		delivery.Status = domain.DeliveryDead
+		delivery.LastError = sendErr.Error()
+	default:
+		next := now.Add(s.backoff(delivery.Attempts))
+		delivery.LastError = sendErr.Error()
+		delivery.NextAttemptAt = &next
+	}
+
+	err = s.store.Update(func(tx repository.Tx) error {
+		return tx.Deliveries().Update(delivery)
+	})
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		log.Printf("webhooks: failed to record delivery %d: %v", id, err)
+	}
+	if delivery.Status == domain.DeliveryDead {
+		log.Printf("webhooks: delivery %d of %s to webhook %d dead-lettered after %d attempts: %s",
+			delivery.ID, delivery.Event, webhook.ID, delivery.Attempts, delivery.LastError)
+	}
+}
+
+// send posts the delivery's payload, reporting any non-2xx response as an error
+func (s *WebhookService) send(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.Event)
+	req.Header.Set(WebhookDeliveryHeader, fmt.Sprint(delivery.ID))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxWebhookResponse))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay after the given number of failed attempts
+func (s *WebhookService) backoff(attempts int) time.Duration {
+	delay := s.opts.Backoff
+	for i := 1; i < attempts && delay < maxWebhookBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxWebhookBackoff {
+		delay = maxWebhookBackoff
+	}
+	return delay
+}
+
+// claim marks a delivery as being attempted; it reports false when it already is
+func (s *WebhookService) claim(id uint) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.inflight[id] {
+		return false
+	}
+	s.inflight[id] = true
+	return true
+}
+
+// release ends the attempt of a delivery
+func (s *WebhookService) release(id uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.inflight, id)
+}
+
+// findWebhook loads a webhook within a transaction, translating repository errors
+func findWebhook(tx repository.Tx, id uint) (*models.Webhook, error) {
+	webhook, err := tx.Webhooks().Get(id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, domain.ErrWebhookNotFound.WithDetail("id", id)
+	}
+	return webhook, err
+}
+
+// validateWebhookFields normalizes and validates a webhook's URL, event filter and secret
+func validateWebhookFields(rawURL string, topics []string, secret string) (string, []string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+
+	var problems []*domain.Error
+	if u, err := url.Parse(rawURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		problems = append(problems, domain.ErrInvalidWebhookURL)
+	}
+	if secret == "" {
+		problems = append(problems, domain.ErrWebhookSecretRequired)
+	}
+
+	unique := make([]string, 0, len(topics))
+	seen := make(map[string]bool)
+	for _, topic := range topics {
+		if !events.IsTopic(topic) {
+			problems = append(problems, domain.ErrInvalidWebhookEvent.WithDetail("event", topic))
+			break
+		}
+		if !seen[topic] {
+			seen[topic] = true
+			unique = append(unique, topic)
+		}
+	}
+
+	if err := domain.JoinValidation(problems...); err != nil {
+		return "", nil, err
+	}
+	return rawURL, unique, nil
+}
+
+// subscribesTo reports whether the webhook wants events of the topic
+func subscribesTo(webhook *models.Webhook, topic string) bool {
+	if len(webhook.Events) == 0 {
+		return true
+	}
+	for _, subscribed := range webhook.Events {
+		if subscribed == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// redactWebhook hides the write-only secret of a webhook returned to callers
+func redactWebhook(webhook *models.Webhook) *models.Webhook {
+	webhook.Secret = ""
+	return webhook
+}
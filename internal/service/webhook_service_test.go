@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/events"
+)
+
+const testWebhookSecret = "s3cret"
+
+// webhookReceiver records the requests posted to a local webhook endpoint
+type webhookReceiver struct {
+	mu       sync.Mutex
+	status   int
+	requests []receivedWebhook
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, receivedWebhook{header: req.Header.Clone(), body: body})
+	w.WriteHeader(r.status)
+}
+
+func (r *webhookReceiver) setStatus(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *webhookReceiver) received() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedWebhook(nil), r.requests...)
+}
+
+func newWebhookTest(t *testing.T, status int) (*UserService, *IssueService, *WebhookService, *webhookReceiver, string) {
+	t.Helper()
+	receiver := &webhookReceiver{status: status}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	opts := WebhookOptions{MaxAttempts: 3, Timeout: time.Second, Backoff: time.Minute}
+	return userService, issueService, NewWebhookService(userService, opts), receiver, server.URL
+}
+
+// drainWebhooks waits until the first attempts of all published events are done
+func drainWebhooks(t *testing.T, userService *UserService, webhookService *WebhookService) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := userService.Events().Stop(ctx); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if err := webhookService.Stop(ctx); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+}
+
+func TestWebhookDeliversSignedPayload(t *testing.T) {
+	userService, issueService, webhookService, receiver, url := newWebhookTest(t, http.StatusOK)
+
+	webhook, err := webhookService.CreateWebhook(domain.CreateWebhookRequest{
+		URL:    url,
+		Events: []string{events.TopicIssueCreated, events.TopicIssueCreated},
+		Secret: testWebhookSecret,
+	}, testAdminID)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if webhook.Secret != "" || len(webhook.Events) != 1 || !webhook.Active {
+		t.Fatalf("Expected an active, redacted webhook with one event, got %+v", webhook)
+	}
+
+	issue, _ := issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	// Not subscribed
+	issueService.UpdateIssue(issue.ID, domain.UpdateIssueRequest{UserID: uintPtr(1)})
+	drainWebhooks(t, userService, webhookService)
+
+	requests := receiver.received()
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 delivery, got %d", len(requests))
+	}
+	request := requests[0]
+	if got := request.header.Get(WebhookEventHeader); got != events.TopicIssueCreated {
+		t.Errorf("Expected event header %q, got %q", events.TopicIssueCreated, got)
+	}
+	if got, want := request.header.Get(WebhookSignatureHeader), SignWebhookPayload(testWebhookSecret, request.body); got != want {
+		t.Errorf("Expected signature %q, got %q", want, got)
+	}
+
+	var payload struct {
+		Event string `json:"event"`
+		Data  struct {
+			Issue struct {
+				ID uint `json:"id"`
+			} `json:"issue"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(request.body, &payload); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if payload.Event != events.TopicIssueCreated || payload.Data.Issue.ID != issue.ID {
+		t.Errorf("Expected payload for issue %d, got %s", issue.ID, request.body)
+	}
+
+	deliveries, err := webhookService.GetDeliveries(webhook.ID, testAdminID)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(deliveries) != 1 || deliveries[0].Status != domain.DeliveryDelivered || deliveries[0].Attempts != 1 || deliveries[0].ResponseCode != http.StatusOK {
+		t.Errorf("Expected one delivered attempt, got %+v", deliveries)
+	}
+}
+
+func TestWebhookRetriesUntilDead(t *testing.T) {
+	userService, issueService, webhookService, receiver, url := newWebhookTest(t, http.StatusInternalServerError)
+	webhook, _ := webhookService.CreateWebhook(domain.CreateWebhookRequest{URL: url, Secret: testWebhookSecret}, testAdminID)
+
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	drainWebhooks(t, userService, webhookService)
+
+	deliveries, _ := webhookService.GetDeliveries(webhook.ID, testAdminID)
+	if len(deliveries) != 1 || deliveries[0].Status != domain.DeliveryPending || deliveries[0].NextAttemptAt == nil {
+		t.Fatalf("Expected a pending delivery awaiting retry, got %+v", deliveries)
+	}
+	first := *deliveries[0].NextAttemptAt
+
+	// Not due yet
+	ctx := context.Background()
+	if err := webhookService.RetryDue(ctx, first.Add(-time.Second)); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if len(receiver.received()) != 1 {
+		t.Fatalf("Expected no retry before the backoff elapsed")
+	}
+
+	// The second retry waits twice as long as the first, counted from the attempt itself
+	started := time.Now()
+	webhookService.RetryDue(ctx, first)
+	deliveries, _ = webhookService.GetDeliveries(webhook.ID, testAdminID)
+	second := deliveries[0]
+	if second.Attempts != 2 || second.NextAttemptAt == nil || second.LastAttemptAt == nil || second.NextAttemptAt.Sub(*second.LastAttemptAt) != 2*time.Minute {
+		t.Fatalf("Expected a second attempt with doubled backoff, got %+v", second)
+	}
+	if second.LastAttemptAt.Before(started) {
+		t.Errorf("Expected the attempt time, got %v before %v", second.LastAttemptAt, started)
+	}
+
+	webhookService.RetryDue(ctx, *deliveries[0].NextAttemptAt)
+	deliveries, _ = webhookService.GetDeliveries(webhook.ID, testAdminID)
+	dead := deliveries[0]
+	if dead.Status != domain.DeliveryDead || dead.Attempts != 3 || dead.NextAttemptAt != nil || dead.ResponseCode != http.StatusInternalServerError || dead.LastError == "" {
+		t.Fatalf("Expected a dead delivery after 3 attempts, got %+v", dead)
+	}
+
+	// Dead deliveries are not retried
+	receiver.setStatus(http.StatusOK)
+	webhookService.RetryDue(ctx, time.Now().Add(24*time.Hour))
+	if len(receiver.received()) != 3 {
+		t.Errorf("Expected 3 attempts, got %d", len(receiver.received()))
+	}
+}
+
+func TestWebhookRetrySucceeds(t *testing.T) {
+	userService, issueService, webhookService, receiver, url := newWebhookTest(t, http.StatusServiceUnavailable)
+	webhook, _ := webhookService.CreateWebhook(domain.CreateWebhookRequest{URL: url, Secret: testWebhookSecret}, testAdminID)
+
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	drainWebhooks(t, userService, webhookService)
+
+	receiver.setStatus(http.StatusNoContent)
+	webhookService.RetryDue(context.Background(), time.Now().Add(time.Hour))
+
+	deliveries, _ := webhookService.GetDeliveries(webhook.ID, testAdminID)
+	if len(deliveries) != 1 || deliveries[0].Status != domain.DeliveryDelivered || deliveries[0].Attempts != 2 || deliveries[0].LastError != "" {
+		t.Fatalf("Expected delivery on retry, got %+v", deliveries)
+	}
+	requests := receiver.received()
+	if len(requests) != 2 || string(requests[0].body) != string(requests[1].body) {
+		t.Errorf("Expected the retry to resend the same payload")
+	}
+}
+
+func TestStalledWebhookDoesNotBlockPublishers(t *testing.T) {
+	stalled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-stalled
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(stalled) })
+
+	userService := NewUserService()
+	issueService := NewIssueService(userService)
+	webhookService := NewWebhookService(userService, WebhookOptions{MaxAttempts: 3, Timeout: time.Minute, Backoff: time.Minute})
+	webhook, _ := webhookService.CreateWebhook(domain.CreateWebhookRequest{URL: server.URL, Secret: testWebhookSecret}, testAdminID)
+
+	// More events than the bus and the workers can queue
+	const issues = 4 * webhookWorkers * webhookQueueSize
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < issues; i++ {
+			issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Expected publishing to go on while the receiver is stalled")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := userService.Events().Stop(ctx); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	// Attempts still waiting for the receiver are given up on shutdown
+	short, cancelShort := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelShort()
+	if err := webhookService.Stop(short); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the stop to time out, got %v", err)
+	}
+
+	deliveries, _ := webhookService.GetDeliveries(webhook.ID, testAdminID)
+	if len(deliveries) != issues {
+		t.Fatalf("Expected %d recorded deliveries, got %d", issues, len(deliveries))
+	}
+	for _, delivery := range deliveries {
+		if delivery.Status != domain.DeliveryPending || delivery.NextAttemptAt == nil {
+			t.Fatalf("Expected every delivery to stay pending for a retry, got %+v", delivery)
+		}
+	}
+}
+
+func TestInactiveWebhookReceivesNothing(t *testing.T) {
+	userService, issueService, webhookService, receiver, url := newWebhookTest(t, http.StatusOK)
+	inactive := false
+	webhook, _ := webhookService.CreateWebhook(domain.CreateWebhookRequest{URL: url, Secret: testWebhookSecret, Active: &inactive}, testAdminID)
+
+	issueService.CreateIssue(domain.CreateIssueRequest{Title: testTitle})
+	drainWebhooks(t, userService, webhookService)
+
+	if len(receiver.received()) != 0 {
+		t.Errorf("Expected no delivery to an inactive webhook")
+	}
+	if deliveries, _ := webhookService.GetDeliveries(webhook.ID, testAdminID); len(deliveries) != 0 {
+		t.Errorf("Expected an empty delivery log, got %+v", deliveries)
+	}
+}
+
+func TestWebhookManagement(t *testing.T) {
+	_, _, webhookService, _, url := newWebhookTest(t, http.StatusOK)
+
+	_, err := webhookService.CreateWebhook(domain.CreateWebhookRequest{URL: url, Secret: testWebhookSecret}, testMemberID)
+	if !errors.Is(err, domain.ErrAdminRequired) {
+		t.Errorf("Expected admin required, got %v", err)
+	}
+
+	_, err = webhookService.CreateWebhook(domain.CreateWebhookRequest{URL: "ftp://example.com", Events: []string{"issue.unknown"}}, testAdminID)
+	var validationErr *domain.Error
+	if !errors.As(err, &validationErr) || validationErr.Kind != domain.KindValidation || len(validationErr.Fields) != 3 {
+		t.Fatalf("Expected url, secret and events validation errors, got %v", err)
+	}
+
+	webhook, _ := webhookService.CreateWebhook(domain.CreateWebhookRequest{URL: url, Secret: testWebhookSecret}, testAdminID)
+	topics := []string{events.TopicUserCreated}
+	inactive := false
+	updated, err := webhookService.UpdateWebhook(webhook.ID, domain.UpdateWebhookRequest{Events: &topics, Active: &inactive}, testAdminID)
+	if err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if updated.Active || len(updated.Events) != 1 || updated.Secret != "" {
+		t.Errorf("Expected an inactive, redacted webhook for user events, got %+v", updated)
+	}
+
+	empty := ""
+	if _, err := webhookService.UpdateWebhook(webhook.ID, domain.UpdateWebhookRequest{Secret: &empty}, testAdminID); !errors.Is(err, domain.ErrWebhookSecretRequired) {
+		t.Errorf("Expected secret required, got %v", err)
+	}
+
+	if err := webhookService.DeleteWebhook(webhook.ID, testAdminID); err != nil {
+		t.Fatalf(errorUnexpected, err)
+	}
+	if _, err := webhookService.GetWebhook(webhook.ID, testAdminID); !errors.Is(err, domain.ErrWebhookNotFound) {
+		t.Errorf("Expected webhook not found, got %v", err)
+	}
+}
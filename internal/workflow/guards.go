@@ -0,0 +1,45 @@
+package workflow
+
+import (
+	"strings"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+)
+
+// Guard names usable in workflow transitions
+const (
+	GuardRequiresAssignee    = "requires_assignee"
+	GuardRequiresDescription = "requires_description"
+	// GuardRequiresUnblocked rejects the transition while an open issue blocks
+	// the issue. Links live outside the issue, so the issue service checks it.
+	GuardRequiresUnblocked = "requires_unblocked"
+)
+
+// serviceGuards are guard names checked by the issue service rather than the workflow
+var serviceGuards = map[string]bool{
+	GuardRequiresUnblocked: true,
+}
+
+// guard checks whether an issue may enter the target state
+type guard func(to string, issue *models.Issue) error
+
+var guards = map[string]guard{
+	GuardRequiresAssignee: func(to string, issue *models.Issue) error {
+		if issue.User == nil {
+			return guardError(domain.ErrAssigneeRequired, "cannot set status to "+to+" without assignee", to)
+		}
+		return nil
+	},
+	GuardRequiresDescription: func(to string, issue *models.Issue) error {
+		if strings.TrimSpace(issue.Description) == "" {
+			return guardError(domain.ErrDescriptionRequired, "cannot set status to "+to+" without description", to)
+		}
+		return nil
+	},
+}
+
+// guardError builds a guard failure that keeps the code of kind but names the target state
+func guardError(kind *domain.Error, message, to string) error {
+	return domain.Conflict(kind.Code, message).WithDetail("status", to)
+}
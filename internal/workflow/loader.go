@@ -0,0 +1,37 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Load reads a workflow definition from a JSON or YAML file and validates it
+func Load(path string) (*Workflow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var w Workflow
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &w)
+	case ".json":
+		err = json.Unmarshal(data, &w)
+	default:
+		return nil, fmt.Errorf("unsupported workflow file format %q", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse workflow %s: %w", path, err)
+	}
+
+	if err := w.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid workflow %s: %w", path, err)
+	}
+	return &w, nil
+}
@@ -0,0 +1,241 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+)
+
+// Automation triggers
+const (
+	// TriggerAssigned fires when an assignee is set on an issue
+	TriggerAssigned = "assigned"
+	// TriggerUnassigned fires when the assignee is removed from an issue
+	TriggerUnassigned = "unassigned"
+)
+
+// State is a status an issue can be in
+type State struct {
+	Name string `json:"name" yaml:"name"`
+	// Final states cannot be left; issues in them can no longer be modified
+	Final bool `json:"final,omitempty" yaml:"final,omitempty"`
+}
+
+// Transition allows moving an issue into To from any of the From states
+type Transition struct {
+	// From lists the source states; empty means every non-final state
+	From []string `json:"from,omitempty" yaml:"from,omitempty"`
+	To   string   `json:"to" yaml:"to"`
+	// Guards are evaluated against the updated issue before the transition is applied
+	Guards []string `json:"guards,omitempty" yaml:"guards,omitempty"`
+}
+
+// Automation moves an issue to another state when a trigger fires
+type Automation struct {
+	On string `json:"on" yaml:"on"`
+	// From lists the states the automation applies to; empty means every non-final state
+	From []string `json:"from,omitempty" yaml:"from,omitempty"`
+	To   string   `json:"to" yaml:"to"`
+	// Override applies the automation even when the request sets a status explicitly
+	Override bool `json:"override,omitempty" yaml:"override,omitempty"`
+}
+
+// Workflow defines the states of an issue and how it may move between them
+type Workflow struct {
+	Name        string       `json:"name" yaml:"name"`
+	Initial     string       `json:"initial" yaml:"initial"`
+	States      []State      `json:"states" yaml:"states"`
+	Transitions []Transition `json:"transitions" yaml:"transitions"`
+	Automations []Automation `json:"automations,omitempty" yaml:"automations,omitempty"`
+}
+
+// Default returns the built-in workflow: PENDING → IN_PROGRESS → COMPLETED/CANCELLED,
+// where working states require an assignee and no open blockers, assigning a
+// PENDING issue starts it and removing the assignee returns it to PENDING.
+func Default() *Workflow {
+	return &Workflow{
+		Name:    "default",
+		Initial: domain.StatusPending,
+		States: []State{
+			{Name: domain.StatusPending},
+			{Name: domain.StatusInProgress},
+			{Name: domain.StatusCompleted, Final: true},
+			{Name: domain.StatusCancelled, Final: true},
+		},
+		Transitions: []Transition{
+			{To: domain.StatusPending},
+			{To: domain.StatusInProgress, Guards: []string{GuardRequiresAssignee, GuardRequiresUnblocked}},
+			{To: domain.StatusCompleted, Guards: []string{GuardRequiresAssignee, GuardRequiresUnblocked}},
+			{To: domain.StatusCancelled},
+		},
+		Automations: []Automation{
+			{On: TriggerAssigned, From: []string{domain.StatusPending}, To: domain.StatusInProgress},
+			{On: TriggerUnassigned, To: domain.StatusPending, Override: true},
+		},
+	}
+}
+
+// Validate checks that the workflow is internally consistent
+func (w *Workflow) Validate() error {
+	if len(w.States) == 0 {
+		return errors.New("workflow defines no states")
+	}
+
+	seen := make(map[string]bool)
+	for _, state := range w.States {
+		if state.Name == "" {
+			return errors.New("workflow state without name")
+		}
+		if seen[state.Name] {
+			return fmt.Errorf("duplicate workflow state %q", state.Name)
+		}
+		seen[state.Name] = true
+	}
+
+	if !w.IsValidState(w.Initial) {
+		return fmt.Errorf("initial state %q is not defined", w.Initial)
+	}
+	if w.IsFinal(w.Initial) {
+		return fmt.Errorf("initial state %q cannot be final", w.Initial)
+	}
+
+	for _, transition := range w.Transitions {
+		if err := w.checkStates(append([]string{transition.To}, transition.From...)); err != nil {
+			return fmt.Errorf("transition to %q: %w", transition.To, err)
+		}
+		for _, guard := range transition.Guards {
+			if _, exists := guards[guard]; !exists && !serviceGuards[guard] {
+				return fmt.Errorf("transition to %q: unknown guard %q", transition.To, guard)
+			}
+		}
+	}
+
+	for _, automation := range w.Automations {
+		if automation.On != TriggerAssigned && automation.On != TriggerUnassigned {
+			return fmt.Errorf("automation to %q: unknown trigger %q", automation.To, automation.On)
+		}
+		if err := w.checkStates(append([]string{automation.To}, automation.From...)); err != nil {
+			return fmt.Errorf("automation to %q: %w", automation.To, err)
+		}
+	}
+
+	return nil
+}
+
+// IsValidState reports whether the workflow defines the given state
+func (w *Workflow) IsValidState(name string) bool {
+	_, exists := w.state(name)
+	return exists
+}
+
+// IsFinal reports whether the given state is final
+func (w *Workflow) IsFinal(name string) bool {
+	state, exists := w.state(name)
+	return exists && state.Final
+}
+
+// StateNames returns the names of all states in definition order
+func (w *Workflow) StateNames() []string {
+	names := make([]string, len(w.States))
+	for i, state := range w.States {
+		names[i] = state.Name
+	}
+	return names
+}
+
+// Automate returns the state an issue in from moves to when trigger fires.
+// explicit reports whether the request already sets a status, in which case
+// only overriding automations apply.
+func (w *Workflow) Automate(trigger, from string, explicit bool) (string, bool) {
+	for _, automation := range w.Automations {
+		if automation.On != trigger || (explicit && !automation.Override) {
+			continue
+		}
+		if w.appliesTo(automation.From, from) {
+			return automation.To, true
+		}
+	}
+	return "", false
+}
+
+// CheckTransition verifies that issue may move from one state to another.
+// The issue must already carry the values it will have after the update.
+func (w *Workflow) CheckTransition(from, to string, issue *models.Issue) error {
+	if from == to {
+		return nil
+	}
+
+	transition, ok := w.transition(from, to)
+	if !ok {
+		return domain.ErrTransitionNotAllowed.WithDetail("from", from).WithDetail("to", to)
+	}
+	for _, name := range transition.Guards {
+		if check, ok := guards[name]; ok {
+			if err := check(to, issue); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// HasGuard reports whether moving from one state to another is guarded by
+// the named guard. Callers use it for guards the workflow cannot check itself.
+func (w *Workflow) HasGuard(from, to, name string) bool {
+	if from == to {
+		return false
+	}
+	transition, ok := w.transition(from, to)
+	if !ok {
+		return false
+	}
+	for _, guard := range transition.Guards {
+		if guard == name {
+			return true
+		}
+	}
+	return false
+}
+
+// transition returns the first transition allowing a move from one state to another
+func (w *Workflow) transition(from, to string) (Transition, bool) {
+	for _, transition := range w.Transitions {
+		if transition.To == to && w.appliesTo(transition.From, from) {
+			return transition, true
+		}
+	}
+	return Transition{}, false
+}
+
+// appliesTo reports whether a From list matches the state
+func (w *Workflow) appliesTo(from []string, state string) bool {
+	if len(from) == 0 {
+		return !w.IsFinal(state)
+	}
+	for _, name := range from {
+		if name == state {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Workflow) state(name string) (State, bool) {
+	for _, state := range w.States {
+		if state.Name == name {
+			return state, true
+		}
+	}
+	return State{}, false
+}
+
+func (w *Workflow) checkStates(names []string) error {
+	for _, name := range names {
+		if !w.IsValidState(name) {
+			return fmt.Errorf("unknown state %q", name)
+		}
+	}
+	return nil
+}
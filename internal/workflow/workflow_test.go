@@ -0,0 +1,153 @@
+package workflow
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"aoroa/internal/domain"
+	"aoroa/internal/models"
+)
+
+const reviewWorkflowYAML = `
+name: review
+initial: PENDING
+states:
+  - name: PENDING
+  - name: IN_PROGRESS
+  - name: IN_REVIEW
+  - name: BLOCKED
+  - name: COMPLETED
+    final: true
+transitions:
+  - to: IN_PROGRESS
+    from: [PENDING, BLOCKED, IN_REVIEW]
+    guards: [requires_assignee]
+  - to: IN_REVIEW
+    from: [IN_PROGRESS]
+    guards: [requires_assignee, requires_description]
+  - to: BLOCKED
+  - to: COMPLETED
+    from: [IN_REVIEW]
+automations:
+  - on: assigned
+    from: [PENDING]
+    to: IN_PROGRESS
+`
+
+func TestDefaultWorkflowIsValid(t *testing.T) {
+	if err := Default().Validate(); err != nil {
+		t.Fatalf("Default workflow is invalid: %v", err)
+	}
+}
+
+func TestDefaultWorkflowMatchesDomainStatuses(t *testing.T) {
+	w := Default()
+	for _, name := range w.StateNames() {
+		if !domain.IsValidStatus(name) {
+			t.Errorf("Default workflow state %s is not a domain status", name)
+		}
+	}
+}
+
+func TestDefaultWorkflowRequiresAssignee(t *testing.T) {
+	w := Default()
+
+	err := w.CheckTransition(domain.StatusPending, domain.StatusInProgress, &models.Issue{})
+	if err == nil || err.Error() != "cannot set status to IN_PROGRESS without assignee" {
+		t.Errorf("Expected assignee guard error, got %v", err)
+	}
+
+	if err := w.CheckTransition(domain.StatusPending, domain.StatusCancelled, &models.Issue{}); err != nil {
+		t.Errorf("Expected PENDING -> CANCELLED without assignee to be allowed, got %v", err)
+	}
+}
+
+func TestDefaultWorkflowRequiresUnblocked(t *testing.T) {
+	w := Default()
+
+	for _, to := range []string{domain.StatusInProgress, domain.StatusCompleted} {
+		if !w.HasGuard(domain.StatusPending, to, GuardRequiresUnblocked) {
+			t.Errorf("Expected PENDING -> %s to require an unblocked issue", to)
+		}
+	}
+	if w.HasGuard(domain.StatusPending, domain.StatusCancelled, GuardRequiresUnblocked) {
+		t.Error("Expected cancelling to ignore blockers")
+	}
+	if w.HasGuard(domain.StatusInProgress, domain.StatusInProgress, GuardRequiresUnblocked) {
+		t.Error("Expected staying in a state to be unguarded")
+	}
+}
+
+func TestDefaultWorkflowAutomations(t *testing.T) {
+	w := Default()
+
+	tests := []struct {
+		name     string
+		trigger  string
+		from     string
+		explicit bool
+		wantTo   string
+		wantOK   bool
+	}{
+		{"Assign pending issue", TriggerAssigned, domain.StatusPending, false, domain.StatusInProgress, true},
+		{"Assign with explicit status", TriggerAssigned, domain.StatusPending, true, "", false},
+		{"Assign in-progress issue", TriggerAssigned, domain.StatusInProgress, false, "", false},
+		{"Unassign overrides explicit status", TriggerUnassigned, domain.StatusInProgress, true, domain.StatusPending, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to, ok := w.Automate(tt.trigger, tt.from, tt.explicit)
+			if to != tt.wantTo || ok != tt.wantOK {
+				t.Errorf("Automate() = (%s, %v), want (%s, %v)", to, ok, tt.wantTo, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestLoadCustomWorkflow(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "workflow.yaml")
+	if err := os.WriteFile(path, []byte(reviewWorkflowYAML), 0o644); err != nil {
+		t.Fatalf("Failed to write workflow: %v", err)
+	}
+
+	w, err := Load(path)
+	if err != nil {
+		t.Fatalf("Failed to load workflow: %v", err)
+	}
+
+	assigned := &models.Issue{User: &models.User{ID: 1}}
+	if err := w.CheckTransition("IN_PROGRESS", "IN_REVIEW", assigned); err == nil {
+		t.Error("Expected requires_description guard to reject an issue without description")
+	}
+	assigned.Description = "ready"
+	if err := w.CheckTransition("IN_PROGRESS", "IN_REVIEW", assigned); err != nil {
+		t.Errorf("Expected IN_PROGRESS -> IN_REVIEW to be allowed, got %v", err)
+	}
+	if err := w.CheckTransition("IN_PROGRESS", "COMPLETED", assigned); err == nil {
+		t.Error("Expected IN_PROGRESS -> COMPLETED to be rejected")
+	}
+}
+
+func TestValidateRejectsInconsistentWorkflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow Workflow
+	}{
+		{"No states", Workflow{Initial: "A"}},
+		{"Unknown initial", Workflow{Initial: "X", States: []State{{Name: "A"}}}},
+		{"Final initial", Workflow{Initial: "A", States: []State{{Name: "A", Final: true}}}},
+		{"Unknown transition target", Workflow{Initial: "A", States: []State{{Name: "A"}}, Transitions: []Transition{{To: "B"}}}},
+		{"Unknown guard", Workflow{Initial: "A", States: []State{{Name: "A"}}, Transitions: []Transition{{To: "A", Guards: []string{"nope"}}}}},
+		{"Unknown trigger", Workflow{Initial: "A", States: []State{{Name: "A"}}, Automations: []Automation{{On: "commented", To: "A"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.workflow.Validate(); err == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+}
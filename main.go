@@ -1,9 +1,11 @@
 package main
 
 import (
+"flag"
 "fmt"
 "os"
 
+"aoroa/internal/config"
 "aoroa/internal/server"
 )
 
@@ -11,28 +13,53 @@ func main() {
 	// 명령행 인자 확인
 	if len(os.Args) > 1 && os.Args[1] == "server" {
 		// 서버 모드
-		runServer()
+		runServer(os.Args[2:])
 	} else {
 		// 기본값: 사용법 출력
 		printUsage()
 	}
 }
 
-func runServer() {
+func runServer(args []string) {
+	flags := flag.NewFlagSet("server", flag.ExitOnError)
+	configFile := flags.String("config", os.Getenv(config.ConfigFileEnv), "설정 파일 (YAML 또는 TOML)")
+	overrides := config.BindFlags(flags)
+	flags.Parse(args)
+
+	// 설정 우선순위: 기본값 < 설정 파일 < AOROA_* 환경 변수 < 명령행 플래그
+	cfg, err := config.Load(*configFile, os.LookupEnv)
+	if err == nil {
+		err = overrides.Apply(cfg)
+	}
+	if err == nil {
+		err = cfg.Validate()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "설정 오류:\n%v\n", err)
+		os.Exit(1)
+	}
+
 	fmt.Println("=== 이슈 관리 API 서버 시작 ===")
-	srv := server.New()
+	srv, err := server.New(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "서버 생성 실패: %v\n", err)
+		os.Exit(1)
+	}
 	srv.Run()
 }
 
 func printUsage() {
 	fmt.Println("=== 이슈 관리 API ===")
 	fmt.Println("사용법:")
-	fmt.Println("  go run main.go server    # 서버 시작")
+	fmt.Println("  go run main.go server                            # 서버 시작 (메모리 저장소)")
+	fmt.Println("  go run main.go server -storage file -data-dir ./data  # 파일 저장소로 서버 시작")
+	fmt.Println("  go run main.go server -framework http            # Gin 대신 표준 net/http로 서버 시작")
+	fmt.Println("  go run main.go server -config ./config.yaml      # 설정 파일(YAML/TOML)로 서버 시작")
 	fmt.Println("  go test ./... -v         # 테스트 실행")
 	fmt.Println("\n서버 시작 후 다음 엔드포인트를 사용할 수 있습니다:")
-	fmt.Println("  POST   /issue           # 이슈 생성")
-	fmt.Println("  GET    /issues          # 이슈 목록 조회")
-	fmt.Println("  GET    /issue/:id       # 특정 이슈 조회")
-	fmt.Println("  PUT    /issue/:id       # 이슈 수정")
+	fmt.Println("  POST   /api/v1/issue     # 이슈 생성")
+	fmt.Println("  GET    /api/v1/issues    # 이슈 목록 조회")
+	fmt.Println("  GET    /api/v1/issue/:id # 특정 이슈 조회")
+	fmt.Println("  PATCH  /api/v1/issue/:id # 이슈 수정")
 	fmt.Println("  GET    /health          # 헬스 체크")
 }
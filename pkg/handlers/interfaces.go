@@ -1,11 +1,85 @@
 package handlers
 
+import (
+	"context"
+	"io"
+	"net/url"
+)
+
+// HandlerFunc is a framework-neutral request handler
+type HandlerFunc func(ctx HTTPContext)
+
+// Middleware runs around the handlers that follow it in a route's chain.
+// It calls ctx.Next to continue the chain or ctx.Abort to stop it.
+type Middleware func(ctx HTTPContext)
+
 // IssueHandlerInterface defines the interface for issue operations
 type IssueHandlerInterface interface {
 	CreateIssue(ctx HTTPContext)
 	GetIssue(ctx HTTPContext)
 	GetIssues(ctx HTTPContext)
 	UpdateIssue(ctx HTTPContext)
+	GetIssueHistory(ctx HTTPContext)
+	GetIssueChildren(ctx HTTPContext)
+	CreateIssueLink(ctx HTTPContext)
+	GetIssueLinks(ctx HTTPContext)
+	DeleteIssueLink(ctx HTTPContext)
+	SearchIssues(ctx HTTPContext)
+	GetChanges(ctx HTTPContext)
+}
+
+// IssueStreamHandlerInterface defines the interface for streaming issue changes
+type IssueStreamHandlerInterface interface {
+	StreamIssues(ctx HTTPContext)
+}
+
+// UserHandlerInterface defines the interface for user operations
+type UserHandlerInterface interface {
+	CreateUser(ctx HTTPContext)
+	GetUser(ctx HTTPContext)
+	GetUsers(ctx HTTPContext)
+	UpdateUser(ctx HTTPContext)
+	DeactivateUser(ctx HTTPContext)
+}
+
+// CommentHandlerInterface defines the interface for issue comment operations
+type CommentHandlerInterface interface {
+	CreateComment(ctx HTTPContext)
+	GetComments(ctx HTTPContext)
+	UpdateComment(ctx HTTPContext)
+	DeleteComment(ctx HTTPContext)
+}
+
+// LabelHandlerInterface defines the interface for label operations
+type LabelHandlerInterface interface {
+	CreateLabel(ctx HTTPContext)
+	GetLabel(ctx HTTPContext)
+	GetLabels(ctx HTTPContext)
+	UpdateLabel(ctx HTTPContext)
+	DeleteLabel(ctx HTTPContext)
+}
+
+// WebhookHandlerInterface defines the interface for webhook operations
+type WebhookHandlerInterface interface {
+	CreateWebhook(ctx HTTPContext)
+	GetWebhook(ctx HTTPContext)
+	GetWebhooks(ctx HTTPContext)
+	UpdateWebhook(ctx HTTPContext)
+	DeleteWebhook(ctx HTTPContext)
+	GetWebhookDeliveries(ctx HTTPContext)
+}
+
+// ProjectHandlerInterface defines the interface for project and project-scoped issue operations
+type ProjectHandlerInterface interface {
+	CreateProject(ctx HTTPContext)
+	GetProject(ctx HTTPContext)
+	GetProjects(ctx HTTPContext)
+	UpdateProject(ctx HTTPContext)
+	AddMember(ctx HTTPContext)
+	RemoveMember(ctx HTTPContext)
+	CreateProjectIssue(ctx HTTPContext)
+	GetProjectIssue(ctx HTTPContext)
+	GetProjectIssues(ctx HTTPContext)
 }
 
 // HTTPContext defines an interface for HTTP request/response operations
@@ -15,9 +89,29 @@ type HTTPContext interface {
 	GetParam(key string) string
 	GetQuery(key string) string
 	GetHeader(key string) string
+	GetURL() *url.URL
+	GetMethod() string
 
 	// Response methods
 	JSON(statusCode int, obj interface{})
 	SetHeader(key, value string)
 	Status(code int)
+	// GetStatus returns the response status written so far (200 if none)
+	GetStatus() int
+
+	// Middleware chaining
+	Next()
+	Abort()
+	IsAborted() bool
+
+	// Per-request values shared along the handler chain
+	Set(key string, value interface{})
+	Get(key string) (interface{}, bool)
+
+	// Streaming responses
+	// Context returns the request context, which is done when the client goes away
+	Context() context.Context
+	// Stream calls step with the response body writer until it returns false,
+	// flushing after every call so the client receives the data right away
+	Stream(step func(w io.Writer) bool)
 }
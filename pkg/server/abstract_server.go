@@ -6,22 +6,52 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 )
 
+// Timeouts는 HTTP 서버의 타임아웃 설정입니다. 0이면 제한이 없습니다 (Shutdown 제외)
+type Timeouts struct {
+	Read     time.Duration
+	Write    time.Duration
+	Idle     time.Duration
+	Shutdown time.Duration // 그레이스풀 셧다운 시 진행 중인 요청을 기다리는 최대 시간
+}
+
+// DefaultTimeouts는 기본 타임아웃 설정을 반환합니다
+func DefaultTimeouts() Timeouts {
+	return Timeouts{
+		Shutdown: 30 * time.Second,
+	}
+}
+
 // AbstractServer는 웹 프레임워크에 독립적인 서버 구현입니다
 type AbstractServer struct {
 	framework        WebFramework
 	handlerRegistrar HandlerRegistrar
+	timeouts         Timeouts
+	services         []BackgroundService
 	srv              *http.Server
+
+	shutdownOnce sync.Once
+	shutdownDone chan struct{} // 셧다운이 끝나면 닫힙니다
+	shutdownErr  error
 }
 
-// NewAbstractServer는 새로운 추상 서버를 생성합니다
+// NewAbstractServer는 기본 타임아웃을 사용하는 새로운 추상 서버를 생성합니다
 func NewAbstractServer(framework WebFramework, registrar HandlerRegistrar) ServerInterface {
+	return NewAbstractServerWithTimeouts(framework, registrar, DefaultTimeouts())
+}
+
+// NewAbstractServerWithTimeouts는 주어진 타임아웃을 사용하는 새로운 추상 서버를 생성합니다.
+// services는 서버와 함께 시작되고 종료됩니다
+func NewAbstractServerWithTimeouts(framework WebFramework, registrar HandlerRegistrar, timeouts Timeouts, services ...BackgroundService) ServerInterface {
 	return &AbstractServer{
 		framework:        framework,
 		handlerRegistrar: registrar,
+		timeouts:         timeouts,
+		services:         services,
 	}
 }
 
@@ -33,12 +63,25 @@ func (s *AbstractServer) Initialize() error {
 	return nil
 }
 
-// Start는 서버를 시작합니다
+// Start는 백그라운드 작업을 시작한 뒤 서버를 시작하고, 서버가 종료되면 백그라운드 작업을 중지합니다.
+// 셧다운이 진행 중인 요청을 모두 처리한 뒤에 반환하므로, 반환 후에는 저장소 등을 안전하게 닫을 수 있습니다
 func (s *AbstractServer) Start(addr string) error {
+	for i, service := range s.services {
+		if err := service.Start(); err != nil {
+			s.stopServices(s.services[:i])
+			return err
+		}
+	}
+	defer s.stopServices(s.services)
+
 	s.srv = &http.Server{
-		Addr:    addr,
-		Handler: s.framework.GetHTTPHandler(),
+		Addr:         addr,
+		Handler:      s.framework.GetHTTPHandler(),
+		ReadTimeout:  s.timeouts.Read,
+		WriteTimeout: s.timeouts.Write,
+		IdleTimeout:  s.timeouts.Idle,
 	}
+	s.shutdownDone = make(chan struct{})
 
 	// 그레이스풀 셧다운을 위한 고루틴
 	go func() {
@@ -47,27 +90,50 @@ func (s *AbstractServer) Start(addr string) error {
 		<-quit
 		log.Println("Server is shutting down...")
 
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-
-		if err := s.srv.Shutdown(ctx); err != nil {
+		if err := s.shutdown(); err != nil {
 			log.Printf("Server forced to shutdown: %v", err)
 		}
 	}()
 
 	log.Printf("Server starting on %s", addr)
-	if err := s.srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+	if err := s.srv.ListenAndServe(); err != http.ErrServerClosed {
 		return err
 	}
+	// ListenAndServe는 Shutdown이 시작되자마자 반환하므로 진행 중인 요청이 끝날 때까지 기다립니다
+	s.shutdown()
 	return nil
 }
 
-// Stop은 서버를 중지합니다
+// Stop은 서버를 중지하고 진행 중인 요청이 끝날 때까지 기다립니다
 func (s *AbstractServer) Stop() error {
 	if s.srv != nil {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		return s.srv.Shutdown(ctx)
+		return s.shutdown()
 	}
 	return nil
 }
+
+// shutdown은 진행 중인 요청을 최대 Shutdown 시간 동안 기다리며 서버를 종료합니다.
+// 여러 곳에서 호출해도 한 번만 종료하며, 모든 호출자는 종료가 끝날 때까지 기다립니다
+func (s *AbstractServer) shutdown() error {
+	s.shutdownOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeouts.Shutdown)
+		defer cancel()
+
+		s.shutdownErr = s.srv.Shutdown(ctx)
+		close(s.shutdownDone)
+	})
+	<-s.shutdownDone
+	return s.shutdownErr
+}
+
+// stopServices는 백그라운드 작업을 시작의 역순으로 중지합니다
+func (s *AbstractServer) stopServices(services []BackgroundService) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeouts.Shutdown)
+	defer cancel()
+
+	for i := len(services) - 1; i >= 0; i-- {
+		if err := services[i].Stop(ctx); err != nil {
+			log.Printf("Failed to stop background service: %v", err)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"aoroa/pkg/handlers"
+)
+
+// recordingService records background service lifecycle calls
+type recordingService struct {
+	name     string
+	calls    *[]string
+	startErr error
+}
+
+func (s recordingService) Start() error {
+	*s.calls = append(*s.calls, "start "+s.name)
+	return s.startErr
+}
+
+func (s recordingService) Stop(ctx context.Context) error {
+	*s.calls = append(*s.calls, "stop "+s.name)
+	return nil
+}
+
+// TestAbstractServerRunsBackgroundServicesWithServerLifecycle tests that background services start before and stop after the HTTP server
+func TestAbstractServerRunsBackgroundServicesWithServerLifecycle(t *testing.T) {
+	framework, _ := NewWebFramework(FrameworkStandard)
+
+	var calls []string
+	srv := NewAbstractServerWithTimeouts(framework, nil, DefaultTimeouts(),
+		recordingService{name: "a", calls: &calls},
+		recordingService{name: "b", calls: &calls},
+	)
+
+	// 잘못된 주소로 서버가 즉시 실패해도 시작된 백그라운드 작업은 중지되어야 합니다
+	if err := srv.Start("invalid address"); err == nil {
+		t.Fatal("Expected listen error")
+	}
+
+	expected := []string{"start a", "start b", "stop b", "stop a"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+// TestAbstractServerStopsStartedServicesWhenStartFails tests that a failing background service stops the ones already started
+func TestAbstractServerStopsStartedServicesWhenStartFails(t *testing.T) {
+	framework, _ := NewWebFramework(FrameworkStandard)
+
+	var calls []string
+	failure := errors.New("boom")
+	srv := NewAbstractServerWithTimeouts(framework, nil, DefaultTimeouts(),
+		recordingService{name: "a", calls: &calls},
+		recordingService{name: "b", calls: &calls, startErr: failure},
+	)
+
+	if err := srv.Start(":0"); !errors.Is(err, failure) {
+		t.Fatalf("Expected start failure, got %v", err)
+	}
+
+	expected := []string{"start a", "start b", "stop a"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+// TestAbstractServerStartWaitsForInFlightRequests tests that Start returns only after shutdown has drained running requests
+func TestAbstractServerStartWaitsForInFlightRequests(t *testing.T) {
+	framework, _ := NewWebFramework(FrameworkStandard)
+	entered, release := make(chan struct{}), make(chan struct{})
+	framework.GET("/slow", func(ctx handlers.HTTPContext) {
+		close(entered)
+		<-release
+		ctx.JSON(http.StatusOK, map[string]string{"status": "done"})
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to find a free port: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	var calls []string
+	srv := NewAbstractServerWithTimeouts(framework, nil, DefaultTimeouts(), recordingService{name: "store", calls: &calls})
+	started := make(chan error, 1)
+	go func() { started <- srv.Start(addr) }()
+
+	responses := make(chan int, 1)
+	go func() {
+		for {
+			resp, err := http.Get("http://" + addr + "/slow")
+			if err == nil {
+				resp.Body.Close()
+				responses <- resp.StatusCode
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+	<-entered
+
+	go srv.Stop()
+	select {
+	case err := <-started:
+		t.Fatalf("Expected Start to wait for the running request, returned %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-started; err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if status := <-responses; status != http.StatusOK {
+		t.Errorf("Expected the running request to complete, got %d", status)
+	}
+	if expected := []string{"start store", "stop store"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
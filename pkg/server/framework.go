@@ -0,0 +1,21 @@
+package server
+
+import "fmt"
+
+// 지원하는 웹 프레임워크 이름
+const (
+	FrameworkGin      = "gin"
+	FrameworkStandard = "http"
+)
+
+// NewWebFramework는 이름에 해당하는 WebFramework 구현을 생성합니다
+func NewWebFramework(name string) (WebFramework, error) {
+	switch name {
+	case FrameworkGin:
+		return NewGinFrameworkAdapter(), nil
+	case FrameworkStandard:
+		return NewStandardFrameworkAdapter(), nil
+	default:
+		return nil, fmt.Errorf("unknown web framework %q", name)
+	}
+}
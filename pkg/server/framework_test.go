@@ -0,0 +1,191 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"aoroa/pkg/handlers"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestFrameworksDispatchNeutralHandlers tests that both adapters route framework-neutral handlers with path parameters
+func TestFrameworksDispatchNeutralHandlers(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for _, name := range []string{FrameworkGin, FrameworkStandard} {
+		t.Run(name, func(t *testing.T) {
+			framework, err := NewWebFramework(name)
+			if err != nil {
+				t.Fatalf("NewWebFramework(%s) failed: %v", name, err)
+			}
+
+			framework.GET("/issues/search", func(ctx handlers.HTTPContext) {
+				ctx.JSON(http.StatusOK, map[string]string{"route": "search"})
+			})
+			framework.PATCH("/issue/:id/comments/:commentId", func(ctx handlers.HTTPContext) {
+				ctx.JSON(http.StatusOK, map[string]string{"id": ctx.GetParam("id"), "commentId": ctx.GetParam("commentId")})
+			})
+
+			tests := []struct {
+				method string
+				path   string
+				status int
+				body   string
+			}{
+				{http.MethodGet, "/health", http.StatusOK, `{"status":"ok"}`},
+				{http.MethodGet, "/issues/search", http.StatusOK, `{"route":"search"}`},
+				{http.MethodPatch, "/issue/7/comments/3", http.StatusOK, `{"commentId":"3","id":"7"}`},
+				{http.MethodGet, "/unknown", http.StatusNotFound, ""},
+			}
+			for _, tt := range tests {
+				rr := httptest.NewRecorder()
+				framework.GetHTTPHandler().ServeHTTP(rr, httptest.NewRequest(tt.method, tt.path, nil))
+
+				if rr.Code != tt.status {
+					t.Errorf("%s %s: expected status %d, got %d", tt.method, tt.path, tt.status, rr.Code)
+				}
+				if tt.body != "" && rr.Body.String() != tt.body && rr.Body.String() != tt.body+"\n" {
+					t.Errorf("%s %s: expected body %s, got %s", tt.method, tt.path, tt.body, rr.Body.String())
+				}
+			}
+		})
+	}
+
+	if _, err := NewWebFramework("unknown"); err == nil {
+		t.Error("Expected error for unknown framework")
+	}
+}
+
+// TestFrameworksRunMiddleware tests global and group middleware with chaining, abort, values and recovery
+func TestFrameworksRunMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for _, name := range []string{FrameworkGin, FrameworkStandard} {
+		t.Run(name, func(t *testing.T) {
+			framework, err := NewWebFramework(name)
+			if err != nil {
+				t.Fatalf("NewWebFramework(%s) failed: %v", name, err)
+			}
+
+			var order []string
+			framework.Use(RequestID(), Recovery(), func(ctx handlers.HTTPContext) {
+				order = append(order, "global-before")
+				ctx.Next()
+				order = append(order, "global-after")
+			})
+
+			requireUser := func(ctx handlers.HTTPContext) {
+				if ctx.GetHeader("X-User-ID") == "" {
+					ctx.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+					ctx.Abort()
+					return
+				}
+				ctx.Set("user", ctx.GetHeader("X-User-ID"))
+				ctx.Next()
+			}
+
+			admin := framework.Group("/admin", requireUser)
+			admin.GET("/whoami", func(ctx handlers.HTTPContext) {
+				order = append(order, "handler")
+				user, _ := ctx.Get("user")
+				requestID, _ := ctx.Get(RequestIDKey)
+				ctx.JSON(http.StatusOK, map[string]interface{}{"user": user, "hasRequestID": requestID != ""})
+			})
+			framework.GET("/panic", func(ctx handlers.HTTPContext) {
+				panic("boom")
+			})
+
+			rr := httptest.NewRecorder()
+			framework.GetHTTPHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/admin/whoami", nil))
+			if rr.Code != http.StatusUnauthorized {
+				t.Errorf("Expected aborted request to return %d, got %d", http.StatusUnauthorized, rr.Code)
+			}
+			if len(order) != 2 {
+				t.Errorf("Expected handler to be skipped after abort, got %v", order)
+			}
+
+			order = nil
+			req := httptest.NewRequest(http.MethodGet, "/admin/whoami", nil)
+			req.Header.Set("X-User-ID", "7")
+			rr = httptest.NewRecorder()
+			framework.GetHTTPHandler().ServeHTTP(rr, req)
+			if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), `"user":"7"`) || !strings.Contains(rr.Body.String(), `"hasRequestID":true`) {
+				t.Errorf("Unexpected response %d %s", rr.Code, rr.Body.String())
+			}
+			if strings.Join(order, ",") != "global-before,handler,global-after" {
+				t.Errorf("Unexpected middleware order %v", order)
+			}
+			if rr.Header().Get("X-Request-ID") == "" {
+				t.Error("Expected X-Request-ID response header")
+			}
+
+			rr = httptest.NewRecorder()
+			framework.GetHTTPHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/panic", nil))
+			if rr.Code != http.StatusInternalServerError {
+				t.Errorf("Expected recovered panic to return %d, got %d", http.StatusInternalServerError, rr.Code)
+			}
+		})
+	}
+}
+
+// TestMountRegistersHeadOptionsAndDeprecatedAliases tests nested groups, automatic HEAD/OPTIONS and deprecation headers
+func TestMountRegistersHeadOptionsAndDeprecatedAliases(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	since := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	routes := []Route{
+		{Method: http.MethodGet, Path: "/issue/:id", Handler: func(ctx handlers.HTTPContext) {
+			ctx.JSON(http.StatusOK, map[string]string{"id": ctx.GetParam("id")})
+		}},
+		{Method: http.MethodPatch, Path: "/issue/:id", Handler: func(ctx handlers.HTTPContext) {
+			ctx.JSON(http.StatusOK, map[string]string{"patched": ctx.GetParam("id")})
+		}},
+	}
+
+	for _, name := range []string{FrameworkGin, FrameworkStandard} {
+		t.Run(name, func(t *testing.T) {
+			framework, err := NewWebFramework(name)
+			if err != nil {
+				t.Fatalf("NewWebFramework(%s) failed: %v", name, err)
+			}
+			Mount(framework.Group("/api").Group("/v1"), routes)
+			Mount(framework.Group("", Deprecated(since, "/api/v1")), routes)
+
+			serve := func(method, path string) *httptest.ResponseRecorder {
+				rr := httptest.NewRecorder()
+				framework.GetHTTPHandler().ServeHTTP(rr, httptest.NewRequest(method, path, nil))
+				return rr
+			}
+
+			rr := serve(http.MethodPatch, "/api/v1/issue/5")
+			if rr.Code != http.StatusOK || rr.Header().Get("Deprecation") != "" {
+				t.Errorf("Expected versioned PATCH without deprecation, got %d %v", rr.Code, rr.Header())
+			}
+
+			rr = serve(http.MethodHead, "/api/v1/issue/5")
+			if rr.Code != http.StatusOK {
+				t.Errorf("Expected HEAD to be served, got %d", rr.Code)
+			}
+
+			rr = serve(http.MethodOptions, "/api/v1/issue/5")
+			if rr.Code != http.StatusNoContent || rr.Header().Get("Allow") != "GET, HEAD, OPTIONS, PATCH" {
+				t.Errorf("Unexpected OPTIONS response %d, Allow=%q", rr.Code, rr.Header().Get("Allow"))
+			}
+
+			rr = serve(http.MethodGet, "/issue/5")
+			if rr.Code != http.StatusOK {
+				t.Errorf("Expected legacy alias to be served, got %d", rr.Code)
+			}
+			if rr.Header().Get("Deprecation") != "@1767225600" {
+				t.Errorf("Unexpected Deprecation header %q", rr.Header().Get("Deprecation"))
+			}
+			if rr.Header().Get("Link") != `</api/v1/issue/5>; rel="successor-version"` {
+				t.Errorf("Unexpected Link header %q", rr.Header().Get("Link"))
+			}
+		})
+	}
+}
@@ -3,17 +3,27 @@ package server
 import (
 	"net/http"
 
+	"aoroa/pkg/handlers"
+	"aoroa/pkg/utils"
+
 	"github.com/gin-gonic/gin"
 )
 
 // GinFrameworkAdapter는 Gin을 WebFramework 인터페이스에 맞게 어댑터하는 구조체입니다
 type GinFrameworkAdapter struct {
+	ginRouter
 	engine *gin.Engine
 }
 
-// NewGinFrameworkAdapter는 새로운 Gin 어댑터를 생성합니다
+// ginRouter는 Gin 라우터 그룹을 Router 인터페이스에 맞게 어댑터합니다
+type ginRouter struct {
+	group *gin.RouterGroup
+}
+
+// NewGinFrameworkAdapter는 새로운 Gin 어댑터를 생성합니다.
+// 로깅과 패닉 복구는 Gin 기본 미들웨어 대신 프레임워크 중립 미들웨어로 등록합니다
 func NewGinFrameworkAdapter() WebFramework {
-	engine := gin.Default()
+	engine := gin.New()
 
 	// Health check endpoint
 	engine.GET("/health", func(c *gin.Context) {
@@ -21,36 +31,54 @@ func NewGinFrameworkAdapter() WebFramework {
 	})
 
 	return &GinFrameworkAdapter{
-		engine: engine,
+		ginRouter: ginRouter{group: &engine.RouterGroup},
+		engine:    engine,
 	}
 }
 
 // GET은 GET 라우트를 등록합니다
-func (g *GinFrameworkAdapter) GET(path string, handlerFunc interface{}) {
-	if handler, ok := handlerFunc.(gin.HandlerFunc); ok {
-		g.engine.GET(path, handler)
-	}
+func (g ginRouter) GET(path string, handlerFunc handlers.HandlerFunc) {
+	g.group.GET(path, ginHandler(handlerFunc))
 }
 
 // POST는 POST 라우트를 등록합니다
-func (g *GinFrameworkAdapter) POST(path string, handlerFunc interface{}) {
-	if handler, ok := handlerFunc.(gin.HandlerFunc); ok {
-		g.engine.POST(path, handler)
-	}
+func (g ginRouter) POST(path string, handlerFunc handlers.HandlerFunc) {
+	g.group.POST(path, ginHandler(handlerFunc))
 }
 
 // PUT은 PUT 라우트를 등록합니다
-func (g *GinFrameworkAdapter) PUT(path string, handlerFunc interface{}) {
-	if handler, ok := handlerFunc.(gin.HandlerFunc); ok {
-		g.engine.PUT(path, handler)
-	}
+func (g ginRouter) PUT(path string, handlerFunc handlers.HandlerFunc) {
+	g.group.PUT(path, ginHandler(handlerFunc))
+}
+
+// PATCH는 PATCH 라우트를 등록합니다
+func (g ginRouter) PATCH(path string, handlerFunc handlers.HandlerFunc) {
+	g.group.PATCH(path, ginHandler(handlerFunc))
 }
 
 // DELETE는 DELETE 라우트를 등록합니다
-func (g *GinFrameworkAdapter) DELETE(path string, handlerFunc interface{}) {
-	if handler, ok := handlerFunc.(gin.HandlerFunc); ok {
-		g.engine.DELETE(path, handler)
-	}
+func (g ginRouter) DELETE(path string, handlerFunc handlers.HandlerFunc) {
+	g.group.DELETE(path, ginHandler(handlerFunc))
+}
+
+// HEAD는 HEAD 라우트를 등록합니다
+func (g ginRouter) HEAD(path string, handlerFunc handlers.HandlerFunc) {
+	g.group.HEAD(path, ginHandler(handlerFunc))
+}
+
+// OPTIONS는 OPTIONS 라우트를 등록합니다
+func (g ginRouter) OPTIONS(path string, handlerFunc handlers.HandlerFunc) {
+	g.group.OPTIONS(path, ginHandler(handlerFunc))
+}
+
+// Use는 미들웨어를 등록합니다
+func (g ginRouter) Use(middleware ...handlers.Middleware) {
+	g.group.Use(ginMiddleware(middleware)...)
+}
+
+// Group은 라우트 그룹을 생성합니다
+func (g ginRouter) Group(prefix string, middleware ...handlers.Middleware) Router {
+	return ginRouter{group: g.group.Group(prefix, ginMiddleware(middleware)...)}
 }
 
 // Run은 서버를 시작합니다
@@ -62,3 +90,19 @@ func (g *GinFrameworkAdapter) Run(addr string) error {
 func (g *GinFrameworkAdapter) GetHTTPHandler() http.Handler {
 	return g.engine
 }
+
+// ginHandler는 프레임워크 중립 핸들러를 Gin 핸들러로 변환합니다
+func ginHandler(handlerFunc handlers.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		handlerFunc(utils.NewGinContextAdapter(c))
+	}
+}
+
+// ginMiddleware는 프레임워크 중립 미들웨어를 Gin 핸들러로 변환합니다
+func ginMiddleware(middleware []handlers.Middleware) []gin.HandlerFunc {
+	result := make([]gin.HandlerFunc, len(middleware))
+	for i, m := range middleware {
+		result[i] = ginHandler(handlers.HandlerFunc(m))
+	}
+	return result
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"aoroa/pkg/handlers"
+	"aoroa/pkg/utils"
+)
+
+// StandardFrameworkAdapter는 표준 라이브러리 http.ServeMux를 WebFramework 인터페이스에 맞게 어댑터하는 구조체입니다
+type StandardFrameworkAdapter struct {
+	*standardRouter
+}
+
+// standardRouter는 경로 접두사와 미들웨어를 공유하는 ServeMux 라우트 그룹입니다
+type standardRouter struct {
+	mux        *http.ServeMux
+	prefix     string
+	middleware []handlers.Middleware
+}
+
+// NewStandardFrameworkAdapter는 새로운 net/http 어댑터를 생성합니다
+func NewStandardFrameworkAdapter() WebFramework {
+	mux := http.NewServeMux()
+
+	// Health check endpoint
+	mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+	})
+
+	return &StandardFrameworkAdapter{
+		standardRouter: &standardRouter{mux: mux},
+	}
+}
+
+// GET은 GET 라우트를 등록합니다
+func (s *standardRouter) GET(path string, handlerFunc handlers.HandlerFunc) {
+	s.handle(http.MethodGet, path, handlerFunc)
+}
+
+// POST는 POST 라우트를 등록합니다
+func (s *standardRouter) POST(path string, handlerFunc handlers.HandlerFunc) {
+	s.handle(http.MethodPost, path, handlerFunc)
+}
+
+// PUT은 PUT 라우트를 등록합니다
+func (s *standardRouter) PUT(path string, handlerFunc handlers.HandlerFunc) {
+	s.handle(http.MethodPut, path, handlerFunc)
+}
+
+// PATCH는 PATCH 라우트를 등록합니다
+func (s *standardRouter) PATCH(path string, handlerFunc handlers.HandlerFunc) {
+	s.handle(http.MethodPatch, path, handlerFunc)
+}
+
+// DELETE는 DELETE 라우트를 등록합니다
+func (s *standardRouter) DELETE(path string, handlerFunc handlers.HandlerFunc) {
+	s.handle(http.MethodDelete, path, handlerFunc)
+}
+
+// HEAD는 HEAD 라우트를 등록합니다
+func (s *standardRouter) HEAD(path string, handlerFunc handlers.HandlerFunc) {
+	s.handle(http.MethodHead, path, handlerFunc)
+}
+
+// OPTIONS는 OPTIONS 라우트를 등록합니다
+func (s *standardRouter) OPTIONS(path string, handlerFunc handlers.HandlerFunc) {
+	s.handle(http.MethodOptions, path, handlerFunc)
+}
+
+// Use는 미들웨어를 등록합니다
+func (s *standardRouter) Use(middleware ...handlers.Middleware) {
+	s.middleware = append(s.middleware, middleware...)
+}
+
+// Group은 라우트 그룹을 생성합니다
+func (s *standardRouter) Group(prefix string, middleware ...handlers.Middleware) Router {
+	combined := make([]handlers.Middleware, 0, len(s.middleware)+len(middleware))
+	combined = append(combined, s.middleware...)
+	combined = append(combined, middleware...)
+
+	return &standardRouter{
+		mux:        s.mux,
+		prefix:     joinPaths(s.prefix, prefix),
+		middleware: combined,
+	}
+}
+
+// Run은 서버를 시작합니다
+func (s *StandardFrameworkAdapter) Run(addr string) error {
+	return http.ListenAndServe(addr, s.mux)
+}
+
+// GetHTTPHandler는 HTTP 핸들러를 반환합니다
+func (s *StandardFrameworkAdapter) GetHTTPHandler() http.Handler {
+	return s.mux
+}
+
+// handle은 메서드와 경로를 ServeMux 패턴으로 변환하고 미들웨어 체인과 함께 핸들러를 등록합니다
+func (s *standardRouter) handle(method, path string, handlerFunc handlers.HandlerFunc) {
+	chain := make([]handlers.HandlerFunc, 0, len(s.middleware)+1)
+	for _, m := range s.middleware {
+		chain = append(chain, handlers.HandlerFunc(m))
+	}
+	chain = append(chain, handlerFunc)
+
+	pattern := method + " " + muxPattern(joinPaths(s.prefix, path))
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		utils.HandleStandardHTTP(w, r, chain...)
+	})
+}
+
+// joinPaths는 그룹 접두사와 경로를 하나의 경로로 합칩니다
+func joinPaths(prefix, path string) string {
+	if prefix == "" {
+		return path
+	}
+	if path == "" {
+		return prefix
+	}
+	return strings.TrimSuffix(prefix, "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+// muxPattern은 ":id" 형식의 경로 파라미터를 ServeMux의 "{id}" 와일드카드로 변환합니다.
+// "/"로 끝나는 경로는 Gin과 같이 정확히 일치하도록 "{$}"를 붙입니다
+func muxPattern(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	pattern := strings.Join(segments, "/")
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "{$}"
+	}
+	return pattern
+}
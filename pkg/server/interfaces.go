@@ -1,16 +1,33 @@
 package server
 
 import (
+	"context"
 	"net/http"
+
+	"aoroa/pkg/handlers"
 )
 
+// Router 인터페이스는 라우트와 미들웨어 등록을 추상화합니다
+type Router interface {
+	// Route registration. 경로 파라미터는 프레임워크와 무관하게 ":id" 형식으로 지정합니다
+	GET(path string, handlerFunc handlers.HandlerFunc)
+	POST(path string, handlerFunc handlers.HandlerFunc)
+	PUT(path string, handlerFunc handlers.HandlerFunc)
+	PATCH(path string, handlerFunc handlers.HandlerFunc)
+	DELETE(path string, handlerFunc handlers.HandlerFunc)
+	HEAD(path string, handlerFunc handlers.HandlerFunc)
+	OPTIONS(path string, handlerFunc handlers.HandlerFunc)
+
+	// Use는 이후에 등록되는 라우트에 미들웨어를 추가합니다
+	Use(middleware ...handlers.Middleware)
+	// Group은 경로 접두사와 미들웨어를 공유하는 라우트 그룹을 생성합니다.
+	// 그룹은 생성 시점의 상위 미들웨어를 물려받으며 중첩할 수 있습니다
+	Group(prefix string, middleware ...handlers.Middleware) Router
+}
+
 // WebFramework 인터페이스는 웹 프레임워크의 공통 기능을 추상화합니다
 type WebFramework interface {
-	// Route registration
-	GET(path string, handlerFunc interface{})
-	POST(path string, handlerFunc interface{})
-	PUT(path string, handlerFunc interface{})
-	DELETE(path string, handlerFunc interface{})
+	Router
 
 	// Server control
 	Run(addr string) error
@@ -28,3 +45,10 @@ type ServerInterface interface {
 type HandlerRegistrar interface {
 	RegisterRoutes(framework WebFramework) error
 }
+
+// BackgroundService는 서버와 같은 생명주기를 갖는 백그라운드 작업입니다.
+// Start는 서버가 요청을 받기 전에 호출되고, Stop은 HTTP 서버가 종료된 뒤 셧다운 타임아웃 안에서 호출됩니다
+type BackgroundService interface {
+	Start() error
+	Stop(ctx context.Context) error
+}
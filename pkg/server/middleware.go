@@ -0,0 +1,83 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"aoroa/pkg/handlers"
+)
+
+// RequestIDKey는 요청 ID가 저장되는 요청별 값의 키입니다
+const RequestIDKey = "requestID"
+
+// requestIDHeader는 요청 ID를 주고받는 헤더입니다
+const requestIDHeader = "X-Request-ID"
+
+// RequestID는 요청마다 ID를 부여하여 요청별 값과 응답 헤더에 기록합니다.
+// 클라이언트가 X-Request-ID 헤더를 보내면 그 값을 사용합니다
+func RequestID() handlers.Middleware {
+	return func(ctx handlers.HTTPContext) {
+		id := ctx.GetHeader(requestIDHeader)
+		if id == "" {
+			id = newRequestID()
+		}
+		ctx.Set(RequestIDKey, id)
+		ctx.SetHeader(requestIDHeader, id)
+		ctx.Next()
+	}
+}
+
+// Logger는 요청 메서드, 경로, 상태 코드 및 처리 시간을 로그로 남깁니다
+func Logger() handlers.Middleware {
+	return func(ctx handlers.HTTPContext) {
+		start := time.Now()
+		ctx.Next()
+
+		requestID, _ := ctx.Get(RequestIDKey)
+		log.Printf("%s %s %d %s request_id=%v",
+			ctx.GetMethod(), ctx.GetURL().Path, ctx.GetStatus(), time.Since(start), requestID)
+	}
+}
+
+// Recovery는 핸들러의 패닉을 복구하여 500 응답으로 변환하고 나머지 체인을 중단합니다
+func Recovery() handlers.Middleware {
+	return func(ctx handlers.HTTPContext) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("panic recovered: %v\n%s", r, debug.Stack())
+				ctx.JSON(http.StatusInternalServerError, map[string]interface{}{
+					"error": "internal server error",
+					"code":  http.StatusInternalServerError,
+				})
+				ctx.Abort()
+			}
+		}()
+		ctx.Next()
+	}
+}
+
+// newRequestID는 임의의 16바이트 요청 ID를 생성합니다
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// Deprecated는 더 이상 사용되지 않는 라우트임을 알리는 Deprecation(RFC 9745) 헤더와
+// 후속 경로를 가리키는 Link 헤더를 응답에 추가합니다.
+// successorPrefix는 요청 경로 앞에 붙여 후속 버전의 경로를 만듭니다 (예: "/api/v1")
+func Deprecated(since time.Time, successorPrefix string) handlers.Middleware {
+	deprecation := "@" + strconv.FormatInt(since.Unix(), 10)
+	return func(ctx handlers.HTTPContext) {
+		ctx.SetHeader("Deprecation", deprecation)
+		ctx.SetHeader("Link", "<"+successorPrefix+ctx.GetURL().Path+`>; rel="successor-version"`)
+		ctx.Next()
+	}
+}
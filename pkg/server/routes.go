@@ -0,0 +1,78 @@
+package server
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"aoroa/pkg/handlers"
+)
+
+// Route는 메서드, 경로, 핸들러로 이루어진 라우트 정의입니다
+type Route struct {
+	Method  string
+	Path    string
+	Handler handlers.HandlerFunc
+}
+
+// Mount는 라우트 목록을 등록합니다.
+// GET 라우트에는 같은 핸들러로 HEAD를, 모든 경로에는 허용 메서드를 알려주는 OPTIONS를 함께 등록합니다
+func Mount(router Router, routes []Route) {
+	var paths []string
+	allowed := make(map[string][]string)
+
+	for _, route := range routes {
+		register(router, route.Method, route.Path, route.Handler)
+		if route.Method == http.MethodGet {
+			register(router, http.MethodHead, route.Path, route.Handler)
+		}
+
+		if _, exists := allowed[route.Path]; !exists {
+			paths = append(paths, route.Path)
+		}
+		allowed[route.Path] = append(allowed[route.Path], route.Method)
+	}
+
+	for _, path := range paths {
+		methods := allowed[path]
+		for _, method := range methods {
+			if method == http.MethodGet {
+				methods = append(methods, http.MethodHead)
+				break
+			}
+		}
+		methods = append(methods, http.MethodOptions)
+		sort.Strings(methods)
+		router.OPTIONS(path, optionsHandler(strings.Join(methods, ", ")))
+	}
+}
+
+// register는 메서드에 해당하는 Router 메서드로 라우트를 등록합니다
+func register(router Router, method, path string, handlerFunc handlers.HandlerFunc) {
+	switch method {
+	case http.MethodGet:
+		router.GET(path, handlerFunc)
+	case http.MethodPost:
+		router.POST(path, handlerFunc)
+	case http.MethodPut:
+		router.PUT(path, handlerFunc)
+	case http.MethodPatch:
+		router.PATCH(path, handlerFunc)
+	case http.MethodDelete:
+		router.DELETE(path, handlerFunc)
+	case http.MethodHead:
+		router.HEAD(path, handlerFunc)
+	case http.MethodOptions:
+		router.OPTIONS(path, handlerFunc)
+	default:
+		panic("unsupported route method " + method)
+	}
+}
+
+// optionsHandler는 Allow 헤더와 함께 204를 응답합니다
+func optionsHandler(allow string) handlers.HandlerFunc {
+	return func(ctx handlers.HTTPContext) {
+		ctx.SetHeader("Allow", allow)
+		ctx.Status(http.StatusNoContent)
+	}
+}
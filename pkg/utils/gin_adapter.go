@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"context"
+	"io"
+	"net/url"
+
 	"github.com/gin-gonic/gin"
 )
 
@@ -34,6 +38,17 @@ func (g *GinContextAdapter) GetHeader(key string) string {
 	return g.ctx.GetHeader(key)
 }
 
+// GetURL returns a copy of the request URL
+func (g *GinContextAdapter) GetURL() *url.URL {
+	u := *g.ctx.Request.URL
+	return &u
+}
+
+// GetMethod returns the request method
+func (g *GinContextAdapter) GetMethod() string {
+	return g.ctx.Request.Method
+}
+
 // JSON sends a JSON response
 func (g *GinContextAdapter) JSON(statusCode int, obj interface{}) {
 	g.ctx.JSON(statusCode, obj)
@@ -48,3 +63,50 @@ func (g *GinContextAdapter) SetHeader(key, value string) {
 func (g *GinContextAdapter) Status(code int) {
 	g.ctx.Status(code)
 }
+
+// GetStatus returns the response status written so far
+func (g *GinContextAdapter) GetStatus() int {
+	return g.ctx.Writer.Status()
+}
+
+// Next runs the remaining handlers in the chain
+func (g *GinContextAdapter) Next() {
+	g.ctx.Next()
+}
+
+// Abort prevents the remaining handlers in the chain from running
+func (g *GinContextAdapter) Abort() {
+	g.ctx.Abort()
+}
+
+// IsAborted reports whether the chain was aborted
+func (g *GinContextAdapter) IsAborted() bool {
+	return g.ctx.IsAborted()
+}
+
+// Set stores a per-request value
+func (g *GinContextAdapter) Set(key string, value interface{}) {
+	g.ctx.Set(key, value)
+}
+
+// Get returns a per-request value
+func (g *GinContextAdapter) Get(key string) (interface{}, bool) {
+	return g.ctx.Get(key)
+}
+
+// Context returns the request context
+func (g *GinContextAdapter) Context() context.Context {
+	return g.ctx.Request.Context()
+}
+
+// Stream writes the response body step by step, flushing after every step
+func (g *GinContextAdapter) Stream(step func(w io.Writer) bool) {
+	w := g.ctx.Writer
+	for {
+		keepOpen := step(w)
+		w.Flush()
+		if !keepOpen {
+			return
+		}
+	}
+}
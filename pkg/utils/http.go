@@ -3,6 +3,7 @@ package utils
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"aoroa/pkg/handlers"
@@ -16,6 +17,8 @@ type HTTPRequest interface {
 	GetParam(key string) string
 	GetQuery(key string) string
 	GetHeader(key string) string
+	GetURL() *url.URL
+	GetMethod() string
 	BindJSON(obj interface{}) error
 }
 
@@ -24,6 +27,7 @@ type HTTPResponse interface {
 	JSON(statusCode int, obj interface{})
 	SetHeader(key, value string)
 	Status(code int)
+	GetStatus() int
 }
 
 // ParseUintParam parses a string parameter to uint
@@ -57,17 +61,3 @@ func WriteJSONError(w http.ResponseWriter, message string, statusCode int) error
 func DecodeJSONRequest(r *http.Request, v interface{}) error {
 	return json.NewDecoder(r.Body).Decode(v)
 }
-
-// GetHTTPStatusForError returns appropriate HTTP status code for common errors
-func GetHTTPStatusForError(errMsg string) int {
-	switch {
-	case errMsg == "user not found" || errMsg == "issue not found":
-		return http.StatusNotFound
-	case errMsg == "cannot update completed or cancelled issue":
-		return http.StatusConflict
-	case errMsg == "invalid status":
-		return http.StatusBadRequest
-	default:
-		return http.StatusBadRequest
-	}
-}
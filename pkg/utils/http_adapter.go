@@ -1,16 +1,31 @@
 package utils
 
 import (
+	"context"
 	"encoding/json"
+	"io"
+	"math"
 	"net/http"
+	"net/url"
 	"strings"
+
+	"aoroa/pkg/handlers"
 )
 
+// abortIndex is a chain position past any real handler, marking an aborted chain
+const abortIndex = math.MaxInt / 2
+
 // StandardHTTPAdapter adapts standard http.ResponseWriter and http.Request to our HTTPContext interface
 type StandardHTTPAdapter struct {
 	writer  http.ResponseWriter
 	request *http.Request
 	params  map[string]string
+	status  int
+
+	// handler chain state used by Handle/Next/Abort
+	chain  []handlers.HandlerFunc
+	index  int
+	values map[string]interface{}
 }
 
 // NewStandardHTTPAdapter creates a new adapter for standard HTTP
@@ -19,6 +34,7 @@ func NewStandardHTTPAdapter(w http.ResponseWriter, r *http.Request) HTTPContext
 		writer:  w,
 		request: r,
 		params:  make(map[string]string),
+		index:   -1,
 	}
 }
 
@@ -28,7 +44,18 @@ func NewStandardHTTPAdapterWithParams(w http.ResponseWriter, r *http.Request, pa
 		writer:  w,
 		request: r,
 		params:  params,
+		index:   -1,
+	}
+}
+
+// HandleStandardHTTP runs a handler chain (middleware first) for a standard HTTP request
+func HandleStandardHTTP(w http.ResponseWriter, r *http.Request, chain ...handlers.HandlerFunc) {
+	adapter := &StandardHTTPAdapter{
+		writer:  w,
+		request: r,
+		params:  make(map[string]string),
 	}
+	adapter.Handle(chain...)
 }
 
 // BindJSON binds the request body to the given struct
@@ -36,12 +63,12 @@ func (s *StandardHTTPAdapter) BindJSON(obj interface{}) error {
 	return json.NewDecoder(s.request.Body).Decode(obj)
 }
 
-// GetParam gets a URL parameter by key
+// GetParam gets a URL parameter by key, falling back to http.ServeMux path wildcards
 func (s *StandardHTTPAdapter) GetParam(key string) string {
 	if value, exists := s.params[key]; exists {
 		return value
 	}
-	return ""
+	return s.request.PathValue(key)
 }
 
 // GetQuery gets a query parameter by key
@@ -54,9 +81,23 @@ func (s *StandardHTTPAdapter) GetHeader(key string) string {
 	return s.request.Header.Get(key)
 }
 
-// JSON sends a JSON response
+// GetURL returns a copy of the request URL
+func (s *StandardHTTPAdapter) GetURL() *url.URL {
+	u := *s.request.URL
+	return &u
+}
+
+// GetMethod returns the request method
+func (s *StandardHTTPAdapter) GetMethod() string {
+	return s.request.Method
+}
+
+// JSON sends a JSON response, keeping a Content-Type set beforehand (e.g. application/problem+json)
 func (s *StandardHTTPAdapter) JSON(statusCode int, obj interface{}) {
-	s.writer.Header().Set("Content-Type", "application/json")
+	if s.writer.Header().Get("Content-Type") == "" {
+		s.writer.Header().Set("Content-Type", "application/json")
+	}
+	s.status = statusCode
 	s.writer.WriteHeader(statusCode)
 	json.NewEncoder(s.writer).Encode(obj)
 }
@@ -68,9 +109,78 @@ func (s *StandardHTTPAdapter) SetHeader(key, value string) {
 
 // Status sets the response status code
 func (s *StandardHTTPAdapter) Status(code int) {
+	s.status = code
 	s.writer.WriteHeader(code)
 }
 
+// GetStatus returns the response status written so far
+func (s *StandardHTTPAdapter) GetStatus() int {
+	if s.status == 0 {
+		return http.StatusOK
+	}
+	return s.status
+}
+
+// Handle runs the given handler chain against this request
+func (s *StandardHTTPAdapter) Handle(chain ...handlers.HandlerFunc) {
+	s.chain = chain
+	s.index = -1
+	s.Next()
+}
+
+// Next runs the remaining handlers in the chain
+func (s *StandardHTTPAdapter) Next() {
+	s.index++
+	for s.index < len(s.chain) {
+		s.chain[s.index](s)
+		s.index++
+	}
+}
+
+// Abort prevents the remaining handlers in the chain from running
+func (s *StandardHTTPAdapter) Abort() {
+	s.index = abortIndex
+}
+
+// IsAborted reports whether the chain was aborted
+func (s *StandardHTTPAdapter) IsAborted() bool {
+	return s.index >= abortIndex
+}
+
+// Set stores a per-request value
+func (s *StandardHTTPAdapter) Set(key string, value interface{}) {
+	if s.values == nil {
+		s.values = make(map[string]interface{})
+	}
+	s.values[key] = value
+}
+
+// Get returns a per-request value
+func (s *StandardHTTPAdapter) Get(key string) (interface{}, bool) {
+	value, exists := s.values[key]
+	return value, exists
+}
+
+// Context returns the request context
+func (s *StandardHTTPAdapter) Context() context.Context {
+	return s.request.Context()
+}
+
+// Stream writes the response body step by step, flushing after every step
+func (s *StandardHTTPAdapter) Stream(step func(w io.Writer) bool) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	controller := http.NewResponseController(s.writer)
+	for {
+		keepOpen := step(s.writer)
+		controller.Flush()
+		if !keepOpen {
+			return
+		}
+	}
+}
+
 // SetParams sets URL parameters (useful for testing or manual routing)
 func (s *StandardHTTPAdapter) SetParams(params map[string]string) {
 	s.params = params